@@ -13,4 +13,5 @@ require (
 	github.com/zclconf/go-cty v1.10.0 // indirect
 	golang.org/x/tools v0.0.0-20201028111035-eafbe7b904eb // indirect
 	google.golang.org/api v0.34.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0
 )
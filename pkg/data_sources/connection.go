@@ -0,0 +1,89 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var connectionSchema = map[string]*schema.Schema{
+	"project_id": &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "Project ID the connection is in",
+	},
+	"connection_id": &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "ID of the connection",
+	},
+	"name": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Connection name",
+	},
+	"type": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Adapter of the connection, e.g. bigquery",
+	},
+	"is_configured_for_oauth": &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether developers authenticate to the warehouse with OAuth",
+	},
+	"oauth_configuration_id": &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of the OAuth configuration of the account the connection uses, 0 if none",
+	},
+	"state": &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Connection state should be 1 = active, as 2 = deleted",
+	},
+}
+
+func DatasourceConnection() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceConnectionRead,
+		Schema:      connectionSchema,
+	}
+}
+
+func datasourceConnectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(int)
+	connectionId := d.Get("connection_id").(int)
+
+	connection, err := c.GetConnection(connectionId, projectId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", connection.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", connection.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_configured_for_oauth", connection.Details.IsConfiguredForOauth); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("oauth_configuration_id", connection.Details.OAuthConfigurationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("state", connection.State); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d%s%d", projectId, dbt_cloud.ID_DELIMITER, connectionId))
+
+	return diags
+}
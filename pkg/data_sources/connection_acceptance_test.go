@@ -0,0 +1,61 @@
+package data_sources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDbtCloudConnectionDataSource(t *testing.T) {
+
+	randomProjectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomConnectionName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	config := connection(randomProjectName, randomConnectionName)
+
+	check := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("data.dbt_cloud_connection.test", "name", randomConnectionName),
+		resource.TestCheckResourceAttr("data.dbt_cloud_connection.test", "type", "bigquery"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_connection.test", "is_configured_for_oauth"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_connection.test", "state"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
+
+func connection(projectName, connectionName string) string {
+	return fmt.Sprintf(`
+    resource "dbt_cloud_project" "test" {
+		name = "%s"
+		bigquery {
+			name = "%s"
+			details {
+				service_account_private_key = jsonencode({
+					project_id     = "test-project"
+					private_key_id = "test"
+					private_key    = "test"
+					client_email   = "test@test-project.iam.gserviceaccount.com"
+					client_id      = "123"
+				})
+				application_id     = "oauth-client"
+				application_secret = "oauth-secret"
+			}
+		}
+	}
+
+    data "dbt_cloud_connection" "test" {
+		project_id    = dbt_cloud_project.test.id
+		connection_id = dbt_cloud_project.test.connection_id
+	}
+    `, projectName, connectionName)
+}
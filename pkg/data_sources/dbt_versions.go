@@ -0,0 +1,91 @@
+package data_sources
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dbtVersionsSchema = map[string]*schema.Schema{
+	"include_deprecated": &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to also list deprecated versions",
+	},
+	"versions": &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "dbt versions available in the account, as returned by dbt Cloud",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Version, to use as dbt_version",
+				},
+				"description": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Description of the version",
+				},
+				"is_deprecated": &schema.Schema{
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the version is deprecated",
+				},
+			},
+		},
+	},
+	"versionless": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "dbt_version to use to always run the latest dbt",
+	},
+}
+
+func DatasourceDbtVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceDbtVersionsRead,
+		Schema:      dbtVersionsSchema,
+	}
+}
+
+func datasourceDbtVersionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	includeDeprecated := d.Get("include_deprecated").(bool)
+
+	dbtVersions, err := c.GetDbtVersions()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versions := []interface{}{}
+	for _, dbtVersion := range dbtVersions {
+		if dbtVersion.IsDeprecated && !includeDeprecated {
+			continue
+		}
+		versions = append(versions, map[string]interface{}{
+			"version":       dbtVersion.Version,
+			"description":   dbtVersion.Description,
+			"is_deprecated": dbtVersion.IsDeprecated,
+		})
+	}
+
+	if err := d.Set("versions", versions); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("versionless", dbt_cloud.DBT_VERSION_VERSIONLESS); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(c.AccountID))
+
+	return diags
+}
@@ -0,0 +1,36 @@
+package data_sources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDbtCloudDbtVersionsDataSource(t *testing.T) {
+
+	config := `
+    data "dbt_cloud_dbt_versions" "test" {
+    }
+
+    data "dbt_cloud_dbt_versions" "all" {
+        include_deprecated = true
+    }
+    `
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_dbt_versions.test", "versions.0.version"),
+		resource.TestCheckResourceAttr("data.dbt_cloud_dbt_versions.test", "versions.0.is_deprecated", "false"),
+		resource.TestCheckResourceAttr("data.dbt_cloud_dbt_versions.test", "versionless", "versionless"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_dbt_versions.all", "versions.0.version"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
@@ -30,6 +30,11 @@ var environmentSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "Credential ID to create the environment with",
 	},
+	"connection_id": &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Connection ID of the environment, empty when it uses the connection of the project",
+	},
 	"name": &schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -45,6 +50,11 @@ var environmentSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "The type of environment (must be either development or deployment)",
 	},
+	"deployment_type": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of deployment environment, production or staging, empty for a general deployment environment",
+	},
 	"use_custom_branch": &schema.Schema{
 		Type:        schema.TypeBool,
 		Computed:    true,
@@ -86,6 +96,9 @@ func datasourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m in
 	if err := d.Set("credential_id", environment.Credential_Id); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("connection_id", environment.Connection_Id); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("name", environment.Name); err != nil {
 		return diag.FromErr(err)
 	}
@@ -95,6 +108,9 @@ func datasourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m in
 	if err := d.Set("type", environment.Type); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("deployment_type", environment.Deployment_Type); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("use_custom_branch", environment.Use_Custom_Branch); err != nil {
 		return diag.FromErr(err)
 	}
@@ -23,6 +23,7 @@ func TestAccDbtCloudEnvironmentDataSource(t *testing.T) {
 		resource.TestCheckResourceAttrSet("data.dbt_cloud_environment.test", "credential_id"),
 		resource.TestCheckResourceAttrSet("data.dbt_cloud_environment.test", "dbt_version"),
 		resource.TestCheckResourceAttrSet("data.dbt_cloud_environment.test", "type"),
+		resource.TestCheckResourceAttr("data.dbt_cloud_environment.test", "deployment_type", ""),
 		resource.TestCheckResourceAttrSet("data.dbt_cloud_environment.test", "use_custom_branch"),
 		resource.TestCheckResourceAttr("data.dbt_cloud_environment.test", "custom_branch", "customBranchName"),
 	)
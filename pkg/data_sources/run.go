@@ -0,0 +1,243 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var runStatusNames = []string{"queued", "starting", "running", "success", "error", "cancelled"}
+
+// runAttributes describes a run, shared between dbt_cloud_run and the
+// elements of dbt_cloud_runs
+func runAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"job_id": &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "ID of the job the run belongs to",
+		},
+		"environment_id": &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "ID of the environment the run ran in",
+		},
+		"project_id": &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "ID of the project the run belongs to",
+		},
+		"status": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the run, one of queued/ starting/ running/ success/ error/ cancelled",
+		},
+		"status_message": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Message attached to the run status, usually the error",
+		},
+		"git_sha": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Git commit SHA the run ran against",
+		},
+		"git_branch": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Git branch the run ran against",
+		},
+		"cause": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Reason the run was triggered",
+		},
+		"dbt_version": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Version of dbt the run used",
+		},
+		"created_at": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the run was created",
+		},
+		"started_at": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the run started",
+		},
+		"finished_at": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the run finished",
+		},
+		"duration_seconds": &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "How long the run took, in seconds",
+		},
+		"run_url": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "URL of the run in dbt Cloud",
+		},
+		"steps": &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Summary of the steps of the run",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the step, usually the command",
+					},
+					"status": &schema.Schema{
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Status of the step",
+					},
+					"duration_seconds": &schema.Schema{
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "How long the step took, in seconds",
+					},
+				},
+			},
+		},
+	}
+}
+
+func runSchema() map[string]*schema.Schema {
+	s := runAttributes()
+	s["run_id"] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Optional:      true,
+		Computed:      true,
+		Description:   "ID of the run, leave unset to look up the latest run of job_id",
+		ConflictsWith: []string{"status_filter"},
+	}
+	s["job_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "ID of the job to find the latest run of when run_id is not set",
+		AtLeastOneOf: []string{"run_id", "job_id"},
+	}
+	s["status_filter"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Only consider runs with this status when looking up the latest run of job_id, e.g. success",
+		ValidateFunc:  validation.StringInSlice(runStatusNames, false),
+		ConflictsWith: []string{"run_id"},
+	}
+	return s
+}
+
+func DatasourceRun() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceRunRead,
+		Schema:      runSchema(),
+	}
+}
+
+func datasourceRunRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+	var run *dbt_cloud.Run
+
+	if runId, ok := d.GetOk("run_id"); ok {
+		r, err := c.GetRun(runId.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		run = r
+	} else {
+		jobId := d.Get("job_id").(int)
+		statusFilter := d.Get("status_filter").(string)
+
+		runs, err := c.ListRuns(dbt_cloud.RunFilter{
+			JobDefinitionID: jobId,
+			Status:          dbt_cloud.RunStatuses[statusFilter],
+			Limit:           1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(runs) == 0 {
+			if statusFilter != "" {
+				return diag.Errorf("no %s run found for job ID %d", statusFilter, jobId)
+			}
+			return diag.Errorf("no run found for job ID %d", jobId)
+		}
+		run = &runs[0]
+	}
+
+	for key, value := range flattenRun(run) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(strconv.Itoa(*run.ID))
+
+	return diags
+}
+
+func flattenRun(run *dbt_cloud.Run) map[string]interface{} {
+	steps := make([]interface{}, len(run.RunSteps))
+	for i, step := range run.RunSteps {
+		steps[i] = map[string]interface{}{
+			"name":             step.Name,
+			"status":           runStatusName(step.Status),
+			"duration_seconds": step.DurationSeconds(),
+		}
+	}
+
+	cause := ""
+	if run.Trigger != nil {
+		cause = run.Trigger.Cause
+	}
+
+	return map[string]interface{}{
+		"run_id":           *run.ID,
+		"job_id":           run.JobDefinitionID,
+		"environment_id":   run.EnvironmentID,
+		"project_id":       run.ProjectID,
+		"status":           runStatusName(run.Status),
+		"status_message":   stringValue(run.StatusMessage),
+		"git_sha":          stringValue(run.GitSha),
+		"git_branch":       stringValue(run.GitBranch),
+		"cause":            cause,
+		"dbt_version":      run.DbtVersion,
+		"created_at":       stringValue(run.CreatedAt),
+		"started_at":       stringValue(run.StartedAt),
+		"finished_at":      stringValue(run.FinishedAt),
+		"duration_seconds": run.DurationSeconds(),
+		"run_url":          run.Href,
+		"steps":            steps,
+	}
+}
+
+func runStatusName(status int) string {
+	for name, code := range dbt_cloud.RunStatuses {
+		if code == status {
+			return name
+		}
+	}
+	return fmt.Sprintf("unknown (%d)", status)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,84 @@
+package data_sources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDbtCloudRunDataSource(t *testing.T) {
+
+	randomProjectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomJobName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	config := runs(randomProjectName, randomJobName)
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_run.by_id", "job_id"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_run.by_id", "status"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_run.by_id", "run_url"),
+		resource.TestCheckResourceAttr("data.dbt_cloud_run.by_id", "cause", "Terraform acceptance test"),
+		resource.TestCheckResourceAttrPair("data.dbt_cloud_run.latest", "run_id", "dbt_cloud_job_run.test_run", "run_id"),
+		resource.TestCheckResourceAttr("data.dbt_cloud_runs.test", "runs.#", "1"),
+		resource.TestCheckResourceAttrPair("data.dbt_cloud_runs.test", "runs.0.run_id", "dbt_cloud_job_run.test_run", "run_id"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
+
+func runs(projectName, jobName string) string {
+	return fmt.Sprintf(`
+    resource "dbt_cloud_project" "test_project" {
+        name = "%s"
+    }
+
+    resource "dbt_cloud_environment" "test_environment" {
+        project_id = dbt_cloud_project.test_project.id
+        name = "run_test_env"
+        dbt_version = "0.21.0"
+        type = "deployment"
+    }
+
+    resource "dbt_cloud_job" "test_job" {
+        name = "%s"
+        project_id = dbt_cloud_project.test_project.id
+        environment_id = dbt_cloud_environment.test_environment.environment_id
+        execute_steps = [
+            "dbt run"
+        ]
+        triggers = {
+          "custom_branch_only" : false,
+          "github_webhook" : false,
+          "schedule" : false,
+          "git_provider_webhook": false
+        }
+    }
+
+    resource "dbt_cloud_job_run" "test_run" {
+        job_id = dbt_cloud_job.test_job.id
+        cause = "Terraform acceptance test"
+    }
+
+    data "dbt_cloud_run" "by_id" {
+        run_id = dbt_cloud_job_run.test_run.run_id
+    }
+
+    data "dbt_cloud_run" "latest" {
+        job_id = dbt_cloud_job_run.test_run.job_id
+    }
+
+    data "dbt_cloud_runs" "test" {
+        job_id = dbt_cloud_job_run.test_run.job_id
+    }
+    `, projectName, jobName)
+}
@@ -0,0 +1,331 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func computedStringList(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func computedString(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+var runArtifactsSchema = map[string]*schema.Schema{
+	"run_id": &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "ID of the run to read the artifacts of, leave unset to use the latest successful run of job_id",
+		AtLeastOneOf: []string{"run_id", "job_id"},
+	},
+	"job_id": &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "ID of the job whose latest successful run to read the artifacts of, when run_id is not set",
+		AtLeastOneOf: []string{"run_id", "job_id"},
+	},
+	"select_tags": &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "Only return nodes with any of these tags",
+	},
+	"select_paths": &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateSelectPath,
+		},
+		Description: "Only return nodes defined under any of these paths, relative to the project root, e.g. models/marts or models/staging/*.sql",
+	},
+	"dbt_version":  computedString("Version of dbt that generated the artifacts"),
+	"generated_at": computedString("When the manifest was generated"),
+	"models": &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Selected models of the manifest",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unique_id":       computedString("Unique ID of the model"),
+				"name":            computedString("Name of the model"),
+				"package_name":    computedString("Package the model belongs to"),
+				"path":            computedString("Path of the model file, relative to the project root"),
+				"database":        computedString("Database the model is built in"),
+				"schema":          computedString("Schema the model is built in"),
+				"alias":           computedString("Name of the relation the model builds"),
+				"materialization": computedString("Materialization of the model, e.g. table or view"),
+				"tags":            computedStringList("Tags of the model"),
+			},
+		},
+	},
+	"sources": &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Selected sources of the manifest",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unique_id":    computedString("Unique ID of the source"),
+				"name":         computedString("Name of the source table"),
+				"source_name":  computedString("Name of the source the table belongs to"),
+				"package_name": computedString("Package the source belongs to"),
+				"path":         computedString("Path of the file defining the source, relative to the project root"),
+				"database":     computedString("Database of the source table"),
+				"schema":       computedString("Schema of the source table"),
+				"identifier":   computedString("Name of the source table in the database"),
+				"tags":         computedStringList("Tags of the source"),
+			},
+		},
+	},
+	"exposures": &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Selected exposures of the manifest",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unique_id":    computedString("Unique ID of the exposure"),
+				"name":         computedString("Name of the exposure"),
+				"type":         computedString("Type of the exposure, e.g. dashboard"),
+				"package_name": computedString("Package the exposure belongs to"),
+				"path":         computedString("Path of the file defining the exposure, relative to the project root"),
+				"owner_name":   computedString("Name of the owner of the exposure"),
+				"owner_email":  computedString("Email of the owner of the exposure"),
+				"depends_on":   computedStringList("Unique IDs of the nodes the exposure depends on"),
+				"tags":         computedStringList("Tags of the exposure"),
+			},
+		},
+	},
+	"results": &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Results of the selected nodes executed by the run, from run_results.json",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unique_id": computedString("Unique ID of the node"),
+				"status":    computedString("Status of the node, e.g. success, error, skipped, pass or fail"),
+				"execution_time": &schema.Schema{
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "How long the node took to execute, in seconds",
+				},
+				"started_at":   computedString("When the node started executing"),
+				"completed_at": computedString("When the node finished executing"),
+				"message":      computedString("Message returned for the node, e.g. the error"),
+			},
+		},
+	},
+}
+
+func DatasourceRunArtifacts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceRunArtifactsRead,
+		Schema:      runArtifactsSchema,
+	}
+}
+
+func validateSelectPath(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	if _, err := path.Match(v, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%s: invalid path pattern %q: %s", k, v, err))
+	}
+	return warnings, errors
+}
+
+func datasourceRunArtifactsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	runId := d.Get("run_id").(int)
+	jobId := d.Get("job_id").(int)
+	if runId == 0 {
+		runs, err := c.ListRuns(dbt_cloud.RunFilter{
+			JobDefinitionID: jobId,
+			Status:          dbt_cloud.RUN_STATUS_SUCCESS,
+			Limit:           1,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(runs) == 0 {
+			return diag.Errorf("no successful run found for job ID %d", jobId)
+		}
+		runId = *runs[0].ID
+	} else if jobId == 0 {
+		run, err := c.GetRun(runId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		jobId = run.JobDefinitionID
+	}
+
+	manifest, err := c.GetRunManifest(runId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	runResults, err := c.GetRunResults(runId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	selector := dbt_cloud.ArtifactSelector{
+		Tags:  interfaceSliceToStrings(d.Get("select_tags").([]interface{})),
+		Paths: interfaceSliceToStrings(d.Get("select_paths").([]interface{})),
+	}
+
+	models := []interface{}{}
+	for _, node := range manifest.NodesOfType("model") {
+		if !selector.Matches(node.Tags, node.OriginalFilePath) {
+			continue
+		}
+		alias := stringValue(node.Alias)
+		if alias == "" {
+			alias = node.Name
+		}
+		models = append(models, map[string]interface{}{
+			"unique_id":       node.UniqueID,
+			"name":            node.Name,
+			"package_name":    node.PackageName,
+			"path":            node.OriginalFilePath,
+			"database":        stringValue(node.Database),
+			"schema":          node.Schema,
+			"alias":           alias,
+			"materialization": node.Config.Materialized,
+			"tags":            node.Tags,
+		})
+	}
+
+	sources := []interface{}{}
+	for _, source := range manifest.SortedSources() {
+		if !selector.Matches(source.Tags, source.OriginalFilePath) {
+			continue
+		}
+		identifier := stringValue(source.Identifier)
+		if identifier == "" {
+			identifier = source.Name
+		}
+		sources = append(sources, map[string]interface{}{
+			"unique_id":    source.UniqueID,
+			"name":         source.Name,
+			"source_name":  source.SourceName,
+			"package_name": source.PackageName,
+			"path":         source.OriginalFilePath,
+			"database":     stringValue(source.Database),
+			"schema":       source.Schema,
+			"identifier":   identifier,
+			"tags":         source.Tags,
+		})
+	}
+
+	exposures := []interface{}{}
+	for _, exposure := range manifest.SortedExposures() {
+		if !selector.Matches(exposure.Tags, exposure.OriginalFilePath) {
+			continue
+		}
+		exposures = append(exposures, map[string]interface{}{
+			"unique_id":    exposure.UniqueID,
+			"name":         exposure.Name,
+			"type":         exposure.Type,
+			"package_name": exposure.PackageName,
+			"path":         exposure.OriginalFilePath,
+			"owner_name":   stringValue(exposure.Owner.Name),
+			"owner_email":  exposure.Owner.Email,
+			"depends_on":   exposure.DependsOn.Nodes,
+			"tags":         exposure.Tags,
+		})
+	}
+
+	results := []interface{}{}
+	for _, result := range runResults.Results {
+		// Results only carry the unique ID, the manifest tells where the
+		// node comes from
+		if !selector.IsEmpty() {
+			node, ok := manifest.Nodes[result.UniqueID]
+			if !ok {
+				node, ok = manifest.Sources[result.UniqueID]
+			}
+			if !ok || !selector.Matches(node.Tags, node.OriginalFilePath) {
+				continue
+			}
+		}
+		startedAt, completedAt := "", ""
+		for _, timing := range result.Timing {
+			if timing.Name == "execute" {
+				startedAt = stringValue(timing.StartedAt)
+				completedAt = stringValue(timing.CompletedAt)
+			}
+		}
+		results = append(results, map[string]interface{}{
+			"unique_id":      result.UniqueID,
+			"status":         result.Status,
+			"execution_time": result.ExecutionTime,
+			"started_at":     startedAt,
+			"completed_at":   completedAt,
+			"message":        stringValue(result.Message),
+		})
+	}
+
+	if err := d.Set("run_id", runId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("job_id", jobId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("dbt_version", manifest.Metadata.DbtVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("generated_at", manifest.Metadata.GeneratedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("models", models); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sources", sources); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("exposures", exposures); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(runId))
+
+	return diags
+}
+
+func interfaceSliceToStrings(values []interface{}) []string {
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, value.(string))
+	}
+	return strs
+}
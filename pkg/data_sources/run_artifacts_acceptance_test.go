@@ -0,0 +1,86 @@
+package data_sources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDbtCloudRunArtifactsDataSource(t *testing.T) {
+
+	randomProjectName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	randomJobName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	config := runArtifacts(randomProjectName, randomJobName)
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrPair("data.dbt_cloud_run_artifacts.by_run", "job_id", "dbt_cloud_job.test_job", "id"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_run_artifacts.by_run", "dbt_version"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_run_artifacts.by_run", "models.#"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_run_artifacts.by_run", "results.#"),
+		resource.TestCheckResourceAttrPair("data.dbt_cloud_run_artifacts.latest", "run_id", "dbt_cloud_job_run.test_run", "run_id"),
+		resource.TestCheckResourceAttr("data.dbt_cloud_run_artifacts.selected", "models.#", "0"),
+		resource.TestCheckResourceAttr("data.dbt_cloud_run_artifacts.selected", "results.#", "0"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
+
+func runArtifacts(projectName, jobName string) string {
+	return fmt.Sprintf(`
+    resource "dbt_cloud_project" "test_project" {
+        name = "%s"
+    }
+
+    resource "dbt_cloud_environment" "test_environment" {
+        project_id = dbt_cloud_project.test_project.id
+        name = "run_artifacts_test_env"
+        dbt_version = "0.21.0"
+        type = "deployment"
+    }
+
+    resource "dbt_cloud_job" "test_job" {
+        name = "%s"
+        project_id = dbt_cloud_project.test_project.id
+        environment_id = dbt_cloud_environment.test_environment.environment_id
+        execute_steps = [
+            "dbt run"
+        ]
+        triggers = {
+          "custom_branch_only" : false,
+          "github_webhook" : false,
+          "schedule" : false,
+          "git_provider_webhook": false
+        }
+    }
+
+    resource "dbt_cloud_job_run" "test_run" {
+        job_id = dbt_cloud_job.test_job.id
+        wait_for_completion = true
+    }
+
+    data "dbt_cloud_run_artifacts" "by_run" {
+        run_id = dbt_cloud_job_run.test_run.run_id
+    }
+
+    data "dbt_cloud_run_artifacts" "latest" {
+        job_id = dbt_cloud_job_run.test_run.job_id
+    }
+
+    data "dbt_cloud_run_artifacts" "selected" {
+        run_id = dbt_cloud_job_run.test_run.run_id
+        select_tags = ["no_such_tag"]
+        select_paths = ["no/such/path"]
+    }
+    `, projectName, jobName)
+}
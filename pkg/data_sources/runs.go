@@ -0,0 +1,140 @@
+package data_sources
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func runsSchema() map[string]*schema.Schema {
+	run := runAttributes()
+	run["run_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of the run",
+	}
+
+	return map[string]*schema.Schema{
+		"job_id": &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Only return runs of this job",
+		},
+		"environment_id": &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Only return runs in this environment",
+		},
+		"project_id": &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Only return runs in this project",
+		},
+		"status": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Only return runs with this status, one of queued/ starting/ running/ success/ error/ cancelled",
+			ValidateFunc: validation.StringInSlice(runStatusNames, false),
+		},
+		"created_after": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Only return runs created at or after this RFC 3339 timestamp",
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"created_before": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Only return runs created before this RFC 3339 timestamp",
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"order": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "newest_first",
+			Description:  "Order of the runs, newest_first or oldest_first",
+			ValidateFunc: validation.StringInSlice([]string{"newest_first", "oldest_first"}, false),
+		},
+		"limit": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      100,
+			Description:  "Maximum number of runs to return, 0 for all of them",
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"runs": &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Runs matching the filters",
+			Elem: &schema.Resource{
+				Schema: run,
+			},
+		},
+	}
+}
+
+func DatasourceRuns() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceRunsRead,
+		Schema:      runsSchema(),
+	}
+}
+
+func datasourceRunsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	filter := dbt_cloud.RunFilter{
+		JobDefinitionID: d.Get("job_id").(int),
+		EnvironmentID:   d.Get("environment_id").(int),
+		ProjectID:       d.Get("project_id").(int),
+		Status:          dbt_cloud.RunStatuses[d.Get("status").(string)],
+		Ascending:       d.Get("order").(string) == "oldest_first",
+		Limit:           d.Get("limit").(int),
+	}
+	if createdAfter := d.Get("created_after").(string); createdAfter != "" {
+		t, err := time.Parse(time.RFC3339, createdAfter)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filter.CreatedAfter = t
+	}
+	if createdBefore := d.Get("created_before").(string); createdBefore != "" {
+		t, err := time.Parse(time.RFC3339, createdBefore)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filter.CreatedBefore = t
+	}
+
+	runs, err := c.ListRuns(filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedRuns := make([]interface{}, len(runs))
+	for i := range runs {
+		flattenedRuns[i] = flattenRun(&runs[i])
+	}
+	if err := d.Set("runs", flattenedRuns); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{
+		strconv.Itoa(filter.ProjectID),
+		strconv.Itoa(filter.EnvironmentID),
+		strconv.Itoa(filter.JobDefinitionID),
+		d.Get("status").(string),
+		d.Get("created_after").(string),
+		d.Get("created_before").(string),
+	}, dbt_cloud.ID_DELIMITER))
+
+	return diags
+}
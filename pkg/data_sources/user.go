@@ -0,0 +1,82 @@
+package data_sources
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var userSchema = map[string]*schema.Schema{
+	"email": &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Email of the user, compared case insensitively",
+	},
+	"user_id": &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of the user",
+	},
+	"first_name": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "First name of the user",
+	},
+	"last_name": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Last name of the user",
+	},
+	"license_type": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "License of the user in the account, e.g. developer or read_only",
+	},
+	"group_ids": &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeInt},
+		Description: "IDs of the groups the user is in",
+	},
+}
+
+func DatasourceUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceUserRead,
+		Schema:      userSchema,
+	}
+}
+
+func datasourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	user, err := c.GetUserByEmail(d.Get("email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("user_id", user.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("first_name", user.FirstName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_name", user.LastName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("license_type", user.AccountPermission(c.AccountID).LicenseType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("group_ids", user.GroupIDs(c.AccountID)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(user.ID))
+
+	return diags
+}
@@ -0,0 +1,44 @@
+package data_sources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDbtCloudUserDataSource(t *testing.T) {
+
+	email := os.Getenv("DBT_CLOUD_USER_EMAIL")
+	if email == "" {
+		t.Skip("DBT_CLOUD_USER_EMAIL must be set to the email of a user of the account")
+	}
+
+	config := user(strings.ToUpper(email))
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_user.test", "user_id"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_user.test", "license_type"),
+		resource.TestCheckResourceAttrSet("data.dbt_cloud_user.test", "group_ids.#"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	})
+}
+
+func user(email string) string {
+	return fmt.Sprintf(`
+    data "dbt_cloud_user" "test" {
+        email = "%s"
+    }
+    `, email)
+}
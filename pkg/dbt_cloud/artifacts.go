@@ -0,0 +1,209 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	ManifestArtifact   = "manifest.json"
+	RunResultsArtifact = "run_results.json"
+)
+
+type ArtifactMetadata struct {
+	DbtSchemaVersion string `json:"dbt_schema_version"`
+	DbtVersion       string `json:"dbt_version"`
+	GeneratedAt      string `json:"generated_at"`
+}
+
+type ManifestNodeConfig struct {
+	Materialized string `json:"materialized"`
+}
+
+// ManifestNode is a node of the manifest, either under nodes (models, seeds,
+// snapshots, tests...) or under sources
+type ManifestNode struct {
+	UniqueID         string             `json:"unique_id"`
+	ResourceType     string             `json:"resource_type"`
+	Name             string             `json:"name"`
+	PackageName      string             `json:"package_name"`
+	Path             string             `json:"path"`
+	OriginalFilePath string             `json:"original_file_path"`
+	Database         *string            `json:"database"`
+	Schema           string             `json:"schema"`
+	Alias            *string            `json:"alias"`
+	Identifier       *string            `json:"identifier"`
+	SourceName       string             `json:"source_name"`
+	Tags             []string           `json:"tags"`
+	Config           ManifestNodeConfig `json:"config"`
+}
+
+type ManifestExposureOwner struct {
+	Name  *string `json:"name"`
+	Email string  `json:"email"`
+}
+
+type ManifestExposure struct {
+	UniqueID         string                `json:"unique_id"`
+	Name             string                `json:"name"`
+	Type             string                `json:"type"`
+	PackageName      string                `json:"package_name"`
+	Path             string                `json:"path"`
+	OriginalFilePath string                `json:"original_file_path"`
+	Tags             []string              `json:"tags"`
+	Owner            ManifestExposureOwner `json:"owner"`
+	DependsOn        struct {
+		Nodes []string `json:"nodes"`
+	} `json:"depends_on"`
+}
+
+// Manifest holds the parts of manifest.json the provider uses
+type Manifest struct {
+	Metadata  ArtifactMetadata            `json:"metadata"`
+	Nodes     map[string]ManifestNode     `json:"nodes"`
+	Sources   map[string]ManifestNode     `json:"sources"`
+	Exposures map[string]ManifestExposure `json:"exposures"`
+}
+
+type RunResultTiming struct {
+	Name        string  `json:"name"`
+	StartedAt   *string `json:"started_at"`
+	CompletedAt *string `json:"completed_at"`
+}
+
+type RunResult struct {
+	UniqueID      string            `json:"unique_id"`
+	Status        string            `json:"status"`
+	ExecutionTime float64           `json:"execution_time"`
+	Message       *string           `json:"message"`
+	Failures      *int              `json:"failures"`
+	Timing        []RunResultTiming `json:"timing"`
+}
+
+// RunResults holds the parts of run_results.json the provider uses
+type RunResults struct {
+	Metadata    ArtifactMetadata `json:"metadata"`
+	ElapsedTime float64          `json:"elapsed_time"`
+	Results     []RunResult      `json:"results"`
+}
+
+// GetRunArtifact downloads an artifact of a run, path being relative to the
+// target folder, e.g. manifest.json
+func (c *Client) GetRunArtifact(runID int, artifactPath string) ([]byte, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%d/runs/%d/artifacts/%s", c.HostURL, c.AccountID, runID, artifactPath), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRequest(req)
+}
+
+func (c *Client) GetRunManifest(runID int) (*Manifest, error) {
+	body, err := c.GetRunArtifact(runID, ManifestArtifact)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := Manifest{}
+	err = json.Unmarshal(body, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s of run %d: %w", ManifestArtifact, runID, err)
+	}
+
+	return &manifest, nil
+}
+
+func (c *Client) GetRunResults(runID int) (*RunResults, error) {
+	body, err := c.GetRunArtifact(runID, RunResultsArtifact)
+	if err != nil {
+		return nil, err
+	}
+
+	runResults := RunResults{}
+	err = json.Unmarshal(body, &runResults)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s of run %d: %w", RunResultsArtifact, runID, err)
+	}
+
+	return &runResults, nil
+}
+
+// NodesOfType returns the nodes of a resource type, e.g. model, sorted by
+// unique ID
+func (m *Manifest) NodesOfType(resourceType string) []ManifestNode {
+	nodes := []ManifestNode{}
+	for _, node := range m.Nodes {
+		if node.ResourceType == resourceType {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].UniqueID < nodes[j].UniqueID })
+	return nodes
+}
+
+// SortedSources returns the sources sorted by unique ID
+func (m *Manifest) SortedSources() []ManifestNode {
+	sources := make([]ManifestNode, 0, len(m.Sources))
+	for _, source := range m.Sources {
+		sources = append(sources, source)
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].UniqueID < sources[j].UniqueID })
+	return sources
+}
+
+// SortedExposures returns the exposures sorted by unique ID
+func (m *Manifest) SortedExposures() []ManifestExposure {
+	exposures := make([]ManifestExposure, 0, len(m.Exposures))
+	for _, exposure := range m.Exposures {
+		exposures = append(exposures, exposure)
+	}
+	sort.Slice(exposures, func(i, j int) bool { return exposures[i].UniqueID < exposures[j].UniqueID })
+	return exposures
+}
+
+// ArtifactSelector picks manifest nodes by tag or by path, like dbt's tag:
+// and path: selection methods. An empty selector picks everything, otherwise
+// a node is picked when it matches any of the tags or paths.
+type ArtifactSelector struct {
+	Tags []string
+	// Paths relative to the project root, either directories, files or
+	// glob patterns, e.g. models/marts or models/staging/*.sql
+	Paths []string
+}
+
+func (s ArtifactSelector) IsEmpty() bool {
+	return len(s.Tags) == 0 && len(s.Paths) == 0
+}
+
+// Matches returns whether a node with these tags, defined in filePath, is
+// selected
+func (s ArtifactSelector) Matches(tags []string, filePath string) bool {
+	if s.IsEmpty() {
+		return true
+	}
+
+	for _, selected := range s.Tags {
+		for _, tag := range tags {
+			if tag == selected {
+				return true
+			}
+		}
+	}
+
+	filePath = path.Clean(filePath)
+	for _, selected := range s.Paths {
+		selected = path.Clean(strings.TrimPrefix(selected, "./"))
+		if filePath == selected || strings.HasPrefix(filePath, selected+"/") {
+			return true
+		}
+		if matched, err := path.Match(selected, filePath); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package dbt_cloud_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+const testManifest = `{
+  "metadata": {"dbt_version": "1.0.0", "generated_at": "2021-12-01T10:00:00.000000Z"},
+  "nodes": {
+    "model.shop.orders": {
+      "unique_id": "model.shop.orders", "resource_type": "model", "name": "orders", "package_name": "shop",
+      "original_file_path": "models/marts/orders.sql", "database": "analytics", "schema": "marts",
+      "alias": "fct_orders", "tags": ["finance"], "config": {"materialized": "table"}
+    },
+    "model.shop.stg_customers": {
+      "unique_id": "model.shop.stg_customers", "resource_type": "model", "name": "stg_customers", "package_name": "shop",
+      "original_file_path": "models/staging/stg_customers.sql", "database": "analytics", "schema": "staging",
+      "alias": null, "tags": [], "config": {"materialized": "view"}
+    },
+    "test.shop.not_null_orders_id": {
+      "unique_id": "test.shop.not_null_orders_id", "resource_type": "test", "name": "not_null_orders_id",
+      "original_file_path": "models/marts/schema.yml", "tags": ["finance"], "config": {"materialized": "test"}
+    }
+  },
+  "sources": {
+    "source.shop.raw.customers": {
+      "unique_id": "source.shop.raw.customers", "resource_type": "source", "name": "customers", "source_name": "raw",
+      "original_file_path": "models/staging/sources.yml", "database": "raw", "schema": "shop", "identifier": "customers", "tags": []
+    }
+  },
+  "exposures": {
+    "exposure.shop.revenue": {
+      "unique_id": "exposure.shop.revenue", "name": "revenue", "type": "dashboard",
+      "original_file_path": "models/marts/exposures.yml", "tags": ["finance"],
+      "owner": {"name": null, "email": "data@example.com"}, "depends_on": {"nodes": ["model.shop.orders"]}
+    }
+  }
+}`
+
+const testRunResults = `{
+  "metadata": {"dbt_version": "1.0.0"},
+  "elapsed_time": 12.5,
+  "results": [
+    {"unique_id": "model.shop.orders", "status": "success", "execution_time": 3.25, "message": "SELECT 10",
+     "timing": [{"name": "compile", "started_at": "2021-12-01T10:00:01Z", "completed_at": "2021-12-01T10:00:02Z"},
+                {"name": "execute", "started_at": "2021-12-01T10:00:02Z", "completed_at": "2021-12-01T10:00:05Z"}]}
+  ]
+}`
+
+func artifactsClient(t *testing.T) (*dbt_cloud.Client, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/accounts/1/runs/42/artifacts/manifest.json":
+			w.Write([]byte(testManifest))
+		case "/v2/accounts/1/runs/42/artifacts/run_results.json":
+			w.Write([]byte(testRunResults))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return &dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}, server.Close
+}
+
+func TestGetRunManifest(t *testing.T) {
+	c, done := artifactsClient(t)
+	defer done()
+
+	manifest, err := c.GetRunManifest(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	models := manifest.NodesOfType("model")
+	if len(models) != 2 || models[0].UniqueID != "model.shop.orders" || models[1].UniqueID != "model.shop.stg_customers" {
+		t.Fatalf("unexpected models %+v", models)
+	}
+	if *models[0].Alias != "fct_orders" || models[0].Config.Materialized != "table" || *models[0].Database != "analytics" {
+		t.Errorf("unexpected model %+v", models[0])
+	}
+	if models[1].Alias != nil {
+		t.Errorf("expected no alias, got %s", *models[1].Alias)
+	}
+
+	sources := manifest.SortedSources()
+	if len(sources) != 1 || sources[0].SourceName != "raw" || *sources[0].Identifier != "customers" {
+		t.Errorf("unexpected sources %+v", sources)
+	}
+
+	exposures := manifest.SortedExposures()
+	if len(exposures) != 1 || exposures[0].Owner.Email != "data@example.com" || exposures[0].DependsOn.Nodes[0] != "model.shop.orders" {
+		t.Errorf("unexpected exposures %+v", exposures)
+	}
+}
+
+func TestGetRunResults(t *testing.T) {
+	c, done := artifactsClient(t)
+	defer done()
+
+	runResults, err := c.GetRunResults(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(runResults.Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(runResults.Results))
+	}
+	result := runResults.Results[0]
+	if result.Status != "success" || result.ExecutionTime != 3.25 || len(result.Timing) != 2 || *result.Timing[1].CompletedAt != "2021-12-01T10:00:05Z" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestArtifactSelector(t *testing.T) {
+	cases := []struct {
+		selector dbt_cloud.ArtifactSelector
+		tags     []string
+		path     string
+		expected bool
+	}{
+		{dbt_cloud.ArtifactSelector{}, nil, "models/a.sql", true},
+		{dbt_cloud.ArtifactSelector{Tags: []string{"finance"}}, []string{"daily", "finance"}, "models/a.sql", true},
+		{dbt_cloud.ArtifactSelector{Tags: []string{"finance"}}, []string{"daily"}, "models/a.sql", false},
+		{dbt_cloud.ArtifactSelector{Paths: []string{"models/marts"}}, nil, "models/marts/orders.sql", true},
+		{dbt_cloud.ArtifactSelector{Paths: []string{"./models/marts/"}}, nil, "models/marts/orders.sql", true},
+		{dbt_cloud.ArtifactSelector{Paths: []string{"models/mart"}}, nil, "models/marts/orders.sql", false},
+		{dbt_cloud.ArtifactSelector{Paths: []string{"models/marts/orders.sql"}}, nil, "models/marts/orders.sql", true},
+		{dbt_cloud.ArtifactSelector{Paths: []string{"models/*/stg_*.sql"}}, nil, "models/staging/stg_customers.sql", true},
+		{dbt_cloud.ArtifactSelector{Paths: []string{"models/*/stg_*.sql"}}, nil, "models/marts/orders.sql", false},
+		{dbt_cloud.ArtifactSelector{Tags: []string{"finance"}, Paths: []string{"models/staging"}}, nil, "models/staging/stg_customers.sql", true},
+	}
+
+	for _, c := range cases {
+		if actual := c.selector.Matches(c.tags, c.path); actual != c.expected {
+			t.Errorf("%+v matching %v %s: expected %t, got %t", c.selector, c.tags, c.path, c.expected, actual)
+		}
+	}
+}
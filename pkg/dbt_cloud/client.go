@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 )
 
@@ -18,6 +19,18 @@ type Client struct {
 	Token      string
 	AccountURL string
 	AccountID  int
+
+	// How job execute_steps problems are reported, see CommandValidationWarning
+	ExecuteStepsValidation string
+
+	// Public IP Terraform calls dbt Cloud from, see GetCallerIP
+	CallerIP string
+	// Whether GetCallerIP may detect the IP when CallerIP is empty
+	DetectCallerIP bool
+
+	// dbt versions of the account, cached by GetDbtVersions
+	dbtVersions      []DbtVersion
+	dbtVersionsMutex sync.Mutex
 }
 
 type ResponseStatus struct {
@@ -62,8 +75,8 @@ type AuthResponseData struct {
 
 // AuthResponse -
 type AuthResponse struct {
-	Status ResponseStatus   `json:"status`
-	Data   AuthResponseData `json:"data`
+	Status ResponseStatus   `json:"status"`
+	Data   AuthResponseData `json:"data"`
 }
 
 // NewClient -
@@ -73,6 +86,8 @@ func NewClient(account_id *int, token *string) (*Client, error) {
 		HostURL:    HostURL,
 		Token:      *token,
 		AccountID:  *account_id,
+
+		ExecuteStepsValidation: CommandValidationWarning,
 	}
 
 	if (account_id != nil) && (token != nil) {
@@ -115,6 +130,9 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 		return nil, err
 	}
 
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s url: %s, status: %d, %w, body: %s", req.Method, req.URL, res.StatusCode, ErrNotFound, body)
+	}
 	if (res.StatusCode != http.StatusOK) && (res.StatusCode != 201) {
 		return nil, fmt.Errorf("%s url: %s, status: %d, body: %s", req.Method, req.URL, res.StatusCode, body)
 	}
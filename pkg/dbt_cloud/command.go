@@ -0,0 +1,346 @@
+package dbt_cloud
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	CommandValidationWarning = "warning"
+	CommandValidationError   = "error"
+)
+
+// dbtSubcommands lists the subcommands dbt Cloud can run in a job step, with
+// the first dbt version (major, minor) that shipped them.
+var dbtSubcommands = map[string][2]int{
+	"build":                     {0, 21},
+	"clone":                     {1, 6},
+	"compile":                   {0, 0},
+	"deps":                      {0, 0},
+	"docs generate":             {0, 0},
+	"list":                      {0, 18},
+	"ls":                        {0, 18},
+	"parse":                     {0, 19},
+	"retry":                     {1, 6},
+	"run":                       {0, 0},
+	"run-operation":             {0, 0},
+	"seed":                      {0, 0},
+	"show":                      {1, 5},
+	"snapshot":                  {0, 0},
+	"source freshness":          {0, 21},
+	"source snapshot-freshness": {0, 0},
+	"test":                      {0, 0},
+}
+
+// Subcommands that take a second word, e.g. `dbt docs generate`
+var dbtSubcommandGroups = map[string]bool{
+	"docs":   true,
+	"source": true,
+}
+
+// Flags dbt Cloud manages itself and refuses in job steps
+var dbtForbiddenFlags = map[string]bool{
+	"--profiles-dir": true,
+	"--project-dir":  true,
+	"--profile":      true,
+}
+
+var dbtSelectorFlags = map[string]bool{
+	"--select":  true,
+	"-s":        true,
+	"--models":  true,
+	"-m":        true,
+	"--exclude": true,
+}
+
+var dbtSelectorMethods = map[string]bool{
+	"access":         true,
+	"config":         true,
+	"exposure":       true,
+	"file":           true,
+	"fqn":            true,
+	"group":          true,
+	"metric":         true,
+	"package":        true,
+	"path":           true,
+	"resource_type":  true,
+	"result":         true,
+	"saved_query":    true,
+	"semantic_model": true,
+	"source":         true,
+	"source_status":  true,
+	"state":          true,
+	"tag":            true,
+	"test_name":      true,
+	"test_type":      true,
+	"unit_test":      true,
+	"version":        true,
+	"wildcard":       true,
+}
+
+var dbtSelectorPattern = regexp.MustCompile(`^(@|[0-9]*\+)?(?:([a-z_]+)(\.[A-Za-z0-9_.]+)?:)?([^:+@\s]+)(\+[0-9]*)?$`)
+
+var dbtVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)`)
+
+// ValidateCommand checks that a job step is a dbt command dbt Cloud will
+// accept. Subcommand availability is only checked when dbtVersion is a
+// pinned version.
+func ValidateCommand(step string, dbtVersion string) []error {
+	args, err := splitCommand(step)
+	if err != nil {
+		return []error{fmt.Errorf("step %q: %s", step, err)}
+	}
+	if len(args) == 0 || args[0] != "dbt" {
+		return []error{fmt.Errorf("step %q must start with `dbt`", step)}
+	}
+	if len(args) == 1 {
+		return []error{fmt.Errorf("step %q has no subcommand", step)}
+	}
+
+	errs := []error{}
+
+	subcommand, args := splitSubcommand(args[1:])
+	if _, ok := dbtSubcommands[subcommand]; !ok {
+		errs = append(errs, fmt.Errorf("step %q: unknown subcommand `dbt %s`%s", step, subcommand, suggestSubcommand(subcommand)))
+	} else if err := checkSubcommandVersion(step, subcommand, dbtVersion); err != nil {
+		errs = append(errs, err)
+	}
+
+	for i := 0; i < len(args); i++ {
+		flag := args[i]
+		if !strings.HasPrefix(flag, "-") {
+			// `dbt run-operation` takes the macro name as a positional argument
+			if subcommand == "run-operation" && i == 0 {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("step %q: unexpected argument %q", step, flag))
+			continue
+		}
+
+		var values []string
+		if j := strings.Index(flag, "="); j >= 0 {
+			values = []string{flag[j+1:]}
+			flag = flag[:j]
+		} else {
+			for i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+				values = append(values, args[i+1])
+				i++
+			}
+		}
+
+		switch {
+		case dbtForbiddenFlags[flag]:
+			errs = append(errs, fmt.Errorf("step %q: %s is managed by dbt Cloud and can't be set in a job", step, flag))
+		case dbtSelectorFlags[flag]:
+			if len(values) == 0 {
+				errs = append(errs, fmt.Errorf("step %q: %s needs at least one selector", step, flag))
+			}
+			for _, value := range values {
+				if err := validateSelector(value); err != nil {
+					errs = append(errs, fmt.Errorf("step %q: %s", step, err))
+				}
+			}
+		case flag == "--selector":
+			if len(values) != 1 {
+				errs = append(errs, fmt.Errorf("step %q: --selector takes exactly one selector name", step))
+			}
+		case flag == "--vars" || flag == "--args":
+			if len(values) != 1 {
+				errs = append(errs, fmt.Errorf("step %q: %s takes a single YAML dictionary, quote it", step, flag))
+				continue
+			}
+			vars := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(values[0]), &vars); err != nil {
+				errs = append(errs, fmt.Errorf("step %q: %s is not a valid YAML dictionary: %s", step, flag, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+// ValidateCommandVersion only runs the checks of ValidateCommand that depend
+// on dbtVersion, for callers that already checked the step without a version.
+func ValidateCommandVersion(step string, dbtVersion string) []error {
+	args, err := splitCommand(step)
+	if err != nil || len(args) < 2 || args[0] != "dbt" {
+		return []error{}
+	}
+
+	subcommand, _ := splitSubcommand(args[1:])
+	if err := checkSubcommandVersion(step, subcommand, dbtVersion); err != nil {
+		return []error{err}
+	}
+	return []error{}
+}
+
+// splitSubcommand splits the dbt subcommand, e.g. `docs generate`, from the
+// arguments following it.
+func splitSubcommand(args []string) (string, []string) {
+	subcommand := args[0]
+	args = args[1:]
+	if dbtSubcommandGroups[subcommand] && len(args) > 0 {
+		subcommand = subcommand + " " + args[0]
+		args = args[1:]
+	}
+	return subcommand, args
+}
+
+// checkSubcommandVersion fails when the pinned dbtVersion predates a known
+// subcommand.
+func checkSubcommandVersion(step string, subcommand string, dbtVersion string) error {
+	minVersion, ok := dbtSubcommands[subcommand]
+	if !ok {
+		return nil
+	}
+	if major, minor, ok := parseDbtVersion(dbtVersion); ok && (major < minVersion[0] || (major == minVersion[0] && minor < minVersion[1])) {
+		return fmt.Errorf("step %q: `dbt %s` requires dbt %d.%d or later, the job uses %s", step, subcommand, minVersion[0], minVersion[1], dbtVersion)
+	}
+	return nil
+}
+
+// validateSelector checks a value of a selector flag, where spaces separate
+// the selectors of a union and commas the ones of an intersection
+func validateSelector(selector string) error {
+	parts := []string{}
+	for _, union := range strings.Fields(selector) {
+		parts = append(parts, strings.Split(union, ",")...)
+	}
+	if len(parts) == 0 {
+		return fmt.Errorf("invalid selector %q", selector)
+	}
+
+	for _, part := range parts {
+		match := dbtSelectorPattern.FindStringSubmatch(part)
+		if match == nil {
+			return fmt.Errorf("invalid selector %q", selector)
+		}
+		method := match[2]
+		if method != "" && !dbtSelectorMethods[method] {
+			return fmt.Errorf("unknown selector method %q in %q", method, selector)
+		}
+		if match[3] != "" && method != "config" {
+			return fmt.Errorf("selector method %q in %q does not take arguments", method, selector)
+		}
+		if match[1] == "@" && match[5] != "" {
+			return fmt.Errorf("selector %q can't combine @ with a trailing +", selector)
+		}
+	}
+	return nil
+}
+
+// splitCommand splits a step into arguments the way a POSIX shell would,
+// honouring single quotes, double quotes and backslash escapes.
+func splitCommand(step string) ([]string, error) {
+	args := []string{}
+	var current strings.Builder
+	inArg := false
+	var quote rune
+
+	runes := []rune(step)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else if r == '\\' && i+1 < len(runes) && strings.ContainsRune(`"\$`+"`", runes[i+1]) {
+				i++
+				current.WriteRune(runes[i])
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == '\\':
+			if i+1 < len(runes) {
+				i++
+				current.WriteRune(runes[i])
+				inArg = true
+			}
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+func suggestSubcommand(subcommand string) string {
+	best, bestDistance := "", 3
+	for known := range dbtSubcommands {
+		if distance := levenshtein(subcommand, known); distance < bestDistance || (distance == bestDistance && known < best) {
+			best, bestDistance = known, distance
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean `dbt %s`?", best)
+}
+
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// parseDbtVersion extracts the major and minor version from a dbt Cloud
+// version string such as `1.0.0` or `1.6.0-latest`.
+func parseDbtVersion(version string) (int, int, bool) {
+	match := dbtVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return 0, 0, false
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return major, minor, true
+}
@@ -0,0 +1,84 @@
+package dbt_cloud_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestValidateCommandValid(t *testing.T) {
+	for _, step := range []string{
+		"dbt run",
+		"dbt build --select tag:nightly+ --exclude path:models/staging",
+		"dbt run -s @orders 2+customers,config.materialized:incremental --full-refresh",
+		"dbt test --select source:raw.orders+1 --store-failures",
+		"dbt source freshness",
+		"dbt docs generate",
+		`dbt run --vars '{"start_date": "2021-01-01", "full": true}'`,
+		"dbt run-operation grant_select --args \"{role: reporter}\"",
+		"dbt seed --selector nightly_seeds",
+		`dbt run --select "tag:a tag:b" --exclude 'path:models/staging,tag:wip'`,
+	} {
+		if errs := dbt_cloud.ValidateCommand(step, "1.0.0"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", step, errs)
+		}
+	}
+}
+
+func TestValidateCommandInvalid(t *testing.T) {
+	cases := map[string]string{
+		"run":                                 "must start with `dbt`",
+		"dbt":                                 "has no subcommand",
+		"dbt rn":                              "did you mean `dbt run`?",
+		"dbt docs serve":                      "unknown subcommand `dbt docs serve`",
+		"dbt run --select":                    "needs at least one selector",
+		"dbt run --select tag:":               "invalid selector",
+		`dbt run --select "tag:a colour:b"`:   "unknown selector method",
+		`dbt run --select " "`:                "invalid selector",
+		"dbt run --select colour:blue":        "unknown selector method",
+		"dbt run --select @orders+":           "can't combine @",
+		"dbt run --profiles-dir /tmp":         "--profiles-dir is managed by dbt Cloud",
+		"dbt run --vars '{unclosed: [1, 2'":   "not a valid YAML dictionary",
+		"dbt run --vars {a: 1} {b: 2}":        "takes a single YAML dictionary",
+		"dbt run --select 'orders":            "unterminated ' quote",
+		"dbt run --selector a b":              "exactly one selector name",
+		"dbt run orders":                      "unexpected argument",
+		"dbt run --vars=scalar_not_a_mapping": "not a valid YAML dictionary",
+	}
+
+	for step, expected := range cases {
+		errs := dbt_cloud.ValidateCommand(step, "1.0.0")
+		if len(errs) == 0 {
+			t.Errorf("expected %q to be rejected", step)
+			continue
+		}
+		if !strings.Contains(errs[0].Error(), expected) {
+			t.Errorf("%q: expected error containing %q, got %q", step, expected, errs[0])
+		}
+	}
+}
+
+func TestValidateCommandVersion(t *testing.T) {
+	if errs := dbt_cloud.ValidateCommand("dbt build", "0.20.2"); len(errs) != 1 {
+		t.Errorf("expected dbt build to be rejected on 0.20.2, got %v", errs)
+	}
+	if errs := dbt_cloud.ValidateCommand("dbt retry", "1.6.0-latest"); len(errs) != 0 {
+		t.Errorf("expected dbt retry to be accepted on 1.6.0-latest, got %v", errs)
+	}
+	if errs := dbt_cloud.ValidateCommand("dbt build", ""); len(errs) != 0 {
+		t.Errorf("expected dbt build to be accepted without a pinned version, got %v", errs)
+	}
+}
+
+func TestValidateCommandVersionOnly(t *testing.T) {
+	if errs := dbt_cloud.ValidateCommandVersion("dbt build --foo", "0.20.2"); len(errs) != 1 {
+		t.Errorf("expected only the version of dbt build to be reported on 0.20.2, got %v", errs)
+	}
+	if errs := dbt_cloud.ValidateCommandVersion("dbt biuld", "0.20.2"); len(errs) != 0 {
+		t.Errorf("expected unknown subcommands to be left to ValidateCommand, got %v", errs)
+	}
+	if errs := dbt_cloud.ValidateCommandVersion("dbt source freshness", "0.20.0"); len(errs) != 1 {
+		t.Errorf("expected dbt source freshness to be rejected on 0.20.0, got %v", errs)
+	}
+}
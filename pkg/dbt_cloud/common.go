@@ -1,7 +1,12 @@
 package dbt_cloud
 
+import "errors"
+
 const (
 	STATE_ACTIVE  = 1
 	STATE_DELETED = 2
 	ID_DELIMITER  = ":"
 )
+
+// ErrNotFound is wrapped by errors for objects missing from dbt Cloud
+var ErrNotFound = errors.New("not found")
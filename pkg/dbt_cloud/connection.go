@@ -41,6 +41,11 @@ type ConnectionDetails struct {
 	Retries                 int    `json:"retries"`
 	Location                string `json:"location"`
 	IsConfiguredForOauth    bool   `json:"is_configured_for_oauth"`
+	// OAuth client developers of BigQuery connections authenticate with
+	ApplicationID     string `json:"application_id,omitempty"`
+	ApplicationSecret string `json:"application_secret,omitempty"`
+	// OAuth configuration of the account developers authenticate with
+	OAuthConfigurationID *int `json:"oauth_configuration_id,omitempty"`
 }
 
 type ConnectionResponse struct {
@@ -51,7 +56,7 @@ type ConnectionResponse struct {
 func (c *Client) GetConnection(connectionID int, projectID int) (*Connection, error) {
 	url := fmt.Sprintf("%s/v3/accounts/%s/projects/%d/connections/%d/", c.HostURL, strconv.Itoa(c.AccountID), projectID, connectionID)
 
-	log.Println("Connection GET (url: %s)", url)
+	log.Printf("Connection GET (url: %s)", url)
 
 	req, err := http.NewRequest("GET", url, nil)
 
@@ -106,7 +111,7 @@ func (c *Client) updateCreateConnection(connection *Connection, url string) (*Co
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Connection payload: %s (url: %s)", string(connectionData))
+	log.Printf("Connection payload: %s (url: %s)", string(connectionData), url)
 
 	req, err := http.NewRequest("POST", url, strings.NewReader(string(connectionData)))
 
@@ -56,7 +56,7 @@ func (c *Client) GetCredential(projectId int, credentialId int) (*Credential, er
 	for i, credential := range credentialListResponse.Data {
 		if *credential.ID == credentialId {
 			credential := credentialListResponse.Data[i]
-			log.Println("Credential READ: %s", credential)
+			log.Printf("Credential READ: %v", credential)
 
 			return &credentialListResponse.Data[i], nil
 		}
@@ -99,7 +99,7 @@ func (c *Client) UpdateCredential(projectId int, credentialId int, credential Cr
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Credential POST: %s", string(credentialData))
+	log.Printf("Credential POST: %s", string(credentialData))
 
 	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/credentials/%d", HostURL, c.AccountID, projectId, credentialId), strings.NewReader(string(credentialData)))
 	if err != nil {
@@ -0,0 +1,90 @@
+package dbt_cloud_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"0 0 ? * MON",
+		"0 0 L * *",
+		"0 0 * * 1#2",
+		"@daily",
+		"0 0 30 2 *",
+	} {
+		if _, err := dbt_cloud.ParseCron(expr); err == nil {
+			t.Errorf("expected %q to be rejected", expr)
+		}
+	}
+}
+
+func TestCronScheduleNextN(t *testing.T) {
+	from := time.Date(2021, 11, 5, 10, 30, 0, 0, time.UTC) // a Friday
+
+	cases := []struct {
+		expr     string
+		expected []string
+	}{
+		{
+			expr: "0 */6 * * *",
+			expected: []string{
+				"2021-11-05T12:00:00Z",
+				"2021-11-05T18:00:00Z",
+				"2021-11-06T00:00:00Z",
+			},
+		},
+		{
+			expr: "15 9,17 * * MON-FRI",
+			expected: []string{
+				"2021-11-05T17:15:00Z",
+				"2021-11-08T09:15:00Z",
+				"2021-11-08T17:15:00Z",
+			},
+		},
+		{
+			expr: "0 0 1 jan,jul 7",
+			expected: []string{
+				"2022-01-01T00:00:00Z",
+				"2022-01-02T00:00:00Z",
+				"2022-01-09T00:00:00Z",
+			},
+		},
+		{
+			expr: "0 0 29 2 *",
+			expected: []string{
+				"2024-02-29T00:00:00Z",
+				"2028-02-29T00:00:00Z",
+				"2032-02-29T00:00:00Z",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		schedule, err := dbt_cloud.ParseCron(c.expr)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", c.expr, err)
+		}
+		times := schedule.NextN(from, len(c.expected))
+		if len(times) != len(c.expected) {
+			t.Fatalf("%q: expected %d times, got %d", c.expr, len(c.expected), len(times))
+		}
+		for i, expected := range c.expected {
+			if got := times[i].Format(time.RFC3339); got != expected {
+				t.Errorf("%q: run %d expected %s, got %s", c.expr, i, expected, got)
+			}
+		}
+	}
+}
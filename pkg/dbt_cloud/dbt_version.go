@@ -0,0 +1,86 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// DBT_VERSION_VERSIONLESS is the dbt_version of environments and jobs on the
+// rolling release track, always running the latest dbt
+const DBT_VERSION_VERSIONLESS = "versionless"
+
+type DbtVersion struct {
+	Version      string `json:"version"`
+	Description  string `json:"description"`
+	IsDeprecated bool   `json:"is_deprecated"`
+}
+
+type DbtVersionListResponse struct {
+	Data   []DbtVersion   `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// GetDbtVersions returns the dbt versions the account can use, fetched once
+// per client
+func (c *Client) GetDbtVersions() ([]DbtVersion, error) {
+	c.dbtVersionsMutex.Lock()
+	defer c.dbtVersionsMutex.Unlock()
+
+	if c.dbtVersions != nil {
+		return c.dbtVersions, nil
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%d/versions/", c.HostURL, c.AccountID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	dbtVersionListResponse := DbtVersionListResponse{}
+	err = json.Unmarshal(body, &dbtVersionListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	c.dbtVersions = dbtVersionListResponse.Data
+	if c.dbtVersions == nil {
+		c.dbtVersions = []DbtVersion{}
+	}
+	return c.dbtVersions, nil
+}
+
+// GetDbtVersion returns the details of a version the account can use, nil
+// for versionless, or an error listing close matches for unknown versions
+func (c *Client) GetDbtVersion(version string) (*DbtVersion, error) {
+	if version == DBT_VERSION_VERSIONLESS {
+		return nil, nil
+	}
+
+	versions, err := c.GetDbtVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range versions {
+		if v.Version == version {
+			return &v, nil
+		}
+	}
+
+	suggestions := []string{}
+	for _, v := range versions {
+		if !v.IsDeprecated && levenshtein(version, v.Version) <= 3 {
+			suggestions = append(suggestions, v.Version)
+		}
+	}
+	if len(suggestions) > 0 {
+		return nil, fmt.Errorf("dbt version %q is not available in this account, did you mean %s?", version, strings.Join(suggestions, " or "))
+	}
+	return nil, fmt.Errorf("dbt version %q is not available in this account, use %s or one of the versions listed by the dbt_cloud_dbt_versions data source", version, DBT_VERSION_VERSIONLESS)
+}
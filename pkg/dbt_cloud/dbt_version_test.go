@@ -0,0 +1,55 @@
+package dbt_cloud_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestGetDbtVersion(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v2/accounts/1/versions/" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"data": [
+			{"version": "1.5.0-latest", "description": "1.5", "is_deprecated": false},
+			{"version": "1.4.0-latest", "description": "1.4", "is_deprecated": false},
+			{"version": "1.0.0", "description": "1.0", "is_deprecated": true}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	version, err := c.GetDbtVersion("1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !version.IsDeprecated {
+		t.Errorf("expected 1.0.0 to be deprecated")
+	}
+
+	version, err = c.GetDbtVersion(dbt_cloud.DBT_VERSION_VERSIONLESS)
+	if err != nil || version != nil {
+		t.Errorf("expected versionless to be accepted, got %v, %v", version, err)
+	}
+
+	_, err = c.GetDbtVersion("1.50.0-latest")
+	if err == nil || !strings.Contains(err.Error(), "did you mean 1.5.0-latest") {
+		t.Errorf("expected a suggestion for 1.50.0-latest, got %v", err)
+	}
+
+	_, err = c.GetDbtVersion("nightly")
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("expected no suggestion for nightly, got %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected versions to be fetched once, got %d requests", requests)
+	}
+}
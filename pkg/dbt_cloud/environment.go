@@ -7,20 +7,33 @@ import (
 	"strings"
 )
 
+const (
+	DEPLOYMENT_TYPE_PRODUCTION = "production"
+	DEPLOYMENT_TYPE_STAGING    = "staging"
+)
+
 type EnvironmentResponse struct {
 	Data   Environment    `json:"data"`
 	Status ResponseStatus `json:"status"`
 }
 
+type EnvironmentListResponse struct {
+	Data   []Environment  `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
 type Environment struct {
 	ID                           *int    `json:"id,omitempty"`
 	State                        int     `json:"state,omitempty"`
 	Account_Id                   int     `json:"account_id"`
 	Project_Id                   int     `json:"project_id"`
 	Credential_Id                *int    `json:"credentials_id"`
+	Connection_Id                *int    `json:"connection_id"`
 	Name                         string  `json:"name"`
 	Dbt_Version                  string  `json:"dbt_version"`
 	Type                         string  `json:"type"`
+	Deployment_Type              *string `json:"deployment_type"`
+	Extended_Attributes_Id       *int    `json:"extended_attributes_id"`
 	Use_Custom_Branch            bool    `json:"use_custom_branch"`
 	Custom_Branch                *string `json:"custom_branch"`
 	Environment_Id               *int    `json:"-"`
@@ -53,7 +66,90 @@ func (c *Client) GetEnvironment(projectId int, environmentId int) (*Environment,
 	return &environmentResponse.Data, nil
 }
 
-func (c *Client) CreateEnvironment(isActive bool, projectId int, name string, dbtVersion string, type_ string, useCustomBranch bool, customBranch string, credentialId int) (*Environment, error) {
+// ListEnvironments returns the environments of a project
+func (c *Client) ListEnvironments(projectId int) ([]Environment, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/environments/", c.HostURL, c.AccountID, projectId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentListResponse := EnvironmentListResponse{}
+	err = json.Unmarshal(body, &environmentListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range environmentListResponse.Data {
+		environmentListResponse.Data[i].Environment_Id = environmentListResponse.Data[i].ID
+	}
+	return environmentListResponse.Data, nil
+}
+
+// GetEnvironmentByName returns the active environment of a project with the
+// given name, or an error when there isn't exactly one
+func (c *Client) GetEnvironmentByName(projectId int, name string) (*Environment, error) {
+	environments, err := c.ListEnvironments(projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Environment
+	for i, environment := range environments {
+		if environment.State != STATE_ACTIVE || environment.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("found several environments named %q in project ID %d, use environment IDs instead", name, projectId)
+		}
+		found = &environments[i]
+	}
+	if found == nil {
+		return nil, fmt.Errorf("environment named %q %w in project ID %d", name, ErrNotFound, projectId)
+	}
+	return found, nil
+}
+
+// GetProductionEnvironment returns the active production environment of a
+// project, or nil when there is none
+func (c *Client) GetProductionEnvironment(projectId int) (*Environment, error) {
+	environments, err := c.ListEnvironments(projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, environment := range environments {
+		if environment.State == STATE_ACTIVE && environment.Deployment_Type != nil && *environment.Deployment_Type == DEPLOYMENT_TYPE_PRODUCTION {
+			return &environment, nil
+		}
+	}
+	return nil, nil
+}
+
+// CheckEnvironmentConnection returns an error when a credential can't be used
+// with a connection, i.e. they are for different adapters
+func CheckEnvironmentConnection(connection *Connection, credential *Credential) error {
+	if connection == nil || credential == nil {
+		return nil
+	}
+	if connection.Type == credential.Type {
+		return nil
+	}
+	connectionId, credentialId := 0, 0
+	if connection.ID != nil {
+		connectionId = *connection.ID
+	}
+	if credential.ID != nil {
+		credentialId = *credential.ID
+	}
+	return fmt.Errorf("credential %d is a %s credential and can't be used with connection %d (%s), a %s connection", credentialId, credential.Type, connectionId, connection.Name, connection.Type)
+}
+
+func (c *Client) CreateEnvironment(isActive bool, projectId int, name string, dbtVersion string, type_ string, useCustomBranch bool, customBranch string, credentialId int, deploymentType string, extendedAttributesId int, connectionId int) (*Environment, error) {
 	state := 1
 	if !isActive {
 		state = 2
@@ -71,9 +167,18 @@ func (c *Client) CreateEnvironment(isActive bool, projectId int, name string, db
 	if credentialId != 0 {
 		newEnvironment.Credential_Id = &credentialId
 	}
+	if connectionId != 0 {
+		newEnvironment.Connection_Id = &connectionId
+	}
 	if customBranch != "" {
 		newEnvironment.Custom_Branch = &customBranch
 	}
+	if deploymentType != "" {
+		newEnvironment.Deployment_Type = &deploymentType
+	}
+	if extendedAttributesId != 0 {
+		newEnvironment.Extended_Attributes_Id = &extendedAttributesId
+	}
 	newEnvironmentData, err := json.Marshal(newEnvironment)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,99 @@
+package dbt_cloud_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func environmentsClient(t *testing.T, environments string) (*dbt_cloud.Client, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/accounts/1/projects/2/environments/" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"data": ` + environments + `}`))
+	}))
+
+	return &dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}, server.Close
+}
+
+func TestGetProductionEnvironment(t *testing.T) {
+	c, done := environmentsClient(t, `[
+		{"id": 10, "state": 1, "name": "Development", "type": "development", "deployment_type": null},
+		{"id": 11, "state": 2, "name": "Old Production", "type": "deployment", "deployment_type": "production"},
+		{"id": 12, "state": 1, "name": "Staging", "type": "deployment", "deployment_type": "staging"},
+		{"id": 13, "state": 1, "name": "Production", "type": "deployment", "deployment_type": "production"}
+	]`)
+	defer done()
+
+	production, err := c.GetProductionEnvironment(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if production == nil || *production.ID != 13 || *production.Environment_Id != 13 {
+		t.Errorf("expected environment 13, got %+v", production)
+	}
+}
+
+func TestGetProductionEnvironmentNone(t *testing.T) {
+	c, done := environmentsClient(t, `[
+		{"id": 10, "state": 1, "name": "Development", "type": "development"},
+		{"id": 12, "state": 1, "name": "Staging", "type": "deployment", "deployment_type": "staging"}
+	]`)
+	defer done()
+
+	production, err := c.GetProductionEnvironment(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if production != nil {
+		t.Errorf("expected no production environment, got %+v", production)
+	}
+}
+
+func TestCheckEnvironmentConnection(t *testing.T) {
+	connectionId, credentialId := 3, 4
+	connection := &dbt_cloud.Connection{ID: &connectionId, Name: "Warehouse", Type: dbt_cloud.TypeBigQueryConnection}
+
+	if err := dbt_cloud.CheckEnvironmentConnection(connection, &dbt_cloud.Credential{ID: &credentialId, Type: dbt_cloud.TypeBigQueryCredential}); err != nil {
+		t.Errorf("expected a bigquery credential to match a bigquery connection, got %s", err)
+	}
+
+	err := dbt_cloud.CheckEnvironmentConnection(connection, &dbt_cloud.Credential{ID: &credentialId, Type: dbt_cloud.TypeSnowflakeCredential})
+	if err == nil {
+		t.Fatal("expected a snowflake credential not to match a bigquery connection")
+	}
+	if !strings.Contains(err.Error(), "credential 4 is a snowflake credential") || !strings.Contains(err.Error(), "connection 3 (Warehouse), a bigquery connection") {
+		t.Errorf("unexpected error %s", err)
+	}
+}
+
+func TestGetEnvironmentByName(t *testing.T) {
+	c, done := environmentsClient(t, `[
+		{"id": 10, "state": 1, "name": "Development", "type": "development"},
+		{"id": 11, "state": 2, "name": "Production", "type": "deployment"},
+		{"id": 12, "state": 1, "name": "Production", "type": "deployment"},
+		{"id": 13, "state": 1, "name": "CI", "type": "deployment"},
+		{"id": 14, "state": 1, "name": "CI", "type": "deployment"}
+	]`)
+	defer done()
+
+	environment, err := c.GetEnvironmentByName(2, "Production")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *environment.ID != 12 {
+		t.Errorf("expected the active environment 12, got %d", *environment.ID)
+	}
+
+	if _, err := c.GetEnvironmentByName(2, "Staging"); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	if _, err := c.GetEnvironmentByName(2, "CI"); err == nil || !strings.Contains(err.Error(), "several environments") {
+		t.Errorf("expected an error for duplicate names, got %v", err)
+	}
+}
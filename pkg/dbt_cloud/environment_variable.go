@@ -0,0 +1,261 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const (
+	// dbt Cloud only accepts custom variables named with this prefix
+	EnvironmentVariablePrefix = "DBT_"
+	// Values of variables named with this prefix are masked by dbt Cloud
+	EnvironmentVariableSecretPrefix = "DBT_ENV_SECRET_"
+	// Key of the project wide default among the values of a variable
+	EnvironmentVariableProjectLevel = "project"
+)
+
+// EnvironmentVariable is a project environment variable with its values,
+// keyed by "project" for the default and by environment name for the
+// environment specific ones
+type EnvironmentVariable struct {
+	Name              string
+	ProjectID         int
+	EnvironmentValues map[string]string
+	// System IDs of the values, keyed like EnvironmentValues
+	IDs map[string]int
+}
+
+type EnvironmentVariableListResponse struct {
+	Data   map[string]map[string]EnvironmentVariableValue `json:"data"`
+	Status ResponseStatus                                 `json:"status"`
+}
+
+type environmentVariableBulkRequest struct {
+	ProjectID         int               `json:"project_id"`
+	Name              string            `json:"env_var_name,omitempty"`
+	NewName           string            `json:"new_name,omitempty"`
+	IDs               []int             `json:"ids,omitempty"`
+	EnvironmentValues map[string]string `json:"environment_name_values,omitempty"`
+}
+
+var environmentVariableNameRegexp = regexp.MustCompile(`^[A-Z0-9_]+$`)
+
+// Values that are almost certainly credentials
+var credentialPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+	regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`),
+	regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+	regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}\b`),
+	regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`),
+	regexp.MustCompile(`\bdbt[cu]_[A-Za-z0-9_-]{20,}`),
+	regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`),
+	regexp.MustCompile(`://[^/\s:@]+:[^/\s@]+@`),
+}
+
+// Names that suggest the value is a credential
+var credentialNameRegexp = regexp.MustCompile(`(?i)(PASSWORD|PASSWD|SECRET|TOKEN|API_?KEY|PRIVATE_?KEY|CREDENTIAL)`)
+
+// Opaque strings long and random enough to be generated keys
+var opaqueValueRegexp = regexp.MustCompile(`^[A-Za-z0-9+/=_-]{32,}$`)
+
+// ValidateEnvironmentVariableName checks a name against dbt Cloud's rules
+// for custom environment variables
+func ValidateEnvironmentVariableName(name string) error {
+	if !strings.HasPrefix(name, EnvironmentVariablePrefix) {
+		return fmt.Errorf("environment variable name %q must start with %s", name, EnvironmentVariablePrefix)
+	}
+	if !environmentVariableNameRegexp.MatchString(name) {
+		return fmt.Errorf("environment variable name %q can only contain upper case letters, digits and underscores", name)
+	}
+	if name == EnvironmentVariableSecretPrefix || name == strings.TrimSuffix(EnvironmentVariableSecretPrefix, "_") {
+		return fmt.Errorf("environment variable name %q needs a name after the %s prefix", name, EnvironmentVariableSecretPrefix)
+	}
+	return nil
+}
+
+// LooksLikeCredential returns why a variable value looks like a credential
+// that should be stored under a DBT_ENV_SECRET_ name, or "" when it doesn't
+func LooksLikeCredential(name string, value string) string {
+	if value == "" || IsSecretEnvironmentVariable(name) {
+		return ""
+	}
+	for _, pattern := range credentialPatterns {
+		if pattern.MatchString(value) {
+			return "its value matches a known credential format"
+		}
+	}
+	if credentialNameRegexp.MatchString(name) {
+		return "its name suggests it holds a credential"
+	}
+	if opaqueValueRegexp.MatchString(value) && shannonEntropy(value) >= 4 {
+		return "its value looks like a randomly generated key"
+	}
+	return ""
+}
+
+// shannonEntropy returns the entropy of s in bits per character
+func shannonEntropy(s string) float64 {
+	counts := map[rune]int{}
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// IsSecretEnvironmentVariable returns whether dbt Cloud masks the values of
+// the variable
+func IsSecretEnvironmentVariable(name string) bool {
+	return strings.HasPrefix(name, EnvironmentVariableSecretPrefix)
+}
+
+func (c *Client) GetEnvironmentVariable(projectID int, name string) (*EnvironmentVariable, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/environment-variables/environment/", c.HostURL, c.AccountID, projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	listResponse := EnvironmentVariableListResponse{}
+	err = json.Unmarshal(body, &listResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	values, ok := listResponse.Data[name]
+	if !ok {
+		return nil, fmt.Errorf("environment variable %s %w in project ID %d", name, ErrNotFound, projectID)
+	}
+
+	environmentVariable := EnvironmentVariable{
+		Name:              name,
+		ProjectID:         projectID,
+		EnvironmentValues: map[string]string{},
+		IDs:               map[string]int{},
+	}
+	for level, value := range values {
+		// Levels without a value are listed too, without an ID
+		if value.ID == nil {
+			continue
+		}
+		environmentVariable.EnvironmentValues[level] = value.Value
+		environmentVariable.IDs[level] = *value.ID
+	}
+	if len(environmentVariable.IDs) == 0 {
+		return nil, fmt.Errorf("environment variable %s %w in project ID %d", name, ErrNotFound, projectID)
+	}
+
+	return &environmentVariable, nil
+}
+
+func (c *Client) CreateEnvironmentVariable(projectID int, name string, environmentValues map[string]string) (*EnvironmentVariable, error) {
+	err := c.environmentVariableBulkRequest("POST", projectID, environmentVariableBulkRequest{
+		ProjectID:         projectID,
+		NewName:           name,
+		EnvironmentValues: environmentValues,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetEnvironmentVariable(projectID, name)
+}
+
+// UpdateEnvironmentVariable sets the values of a variable, removing the
+// values of the levels that aren't in environmentValues
+func (c *Client) UpdateEnvironmentVariable(projectID int, name string, environmentValues map[string]string) (*EnvironmentVariable, error) {
+	current, err := c.GetEnvironmentVariable(projectID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int{}
+	for level, id := range current.IDs {
+		if _, ok := environmentValues[level]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		err = c.deleteEnvironmentVariableValue(projectID, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = c.environmentVariableBulkRequest("PUT", projectID, environmentVariableBulkRequest{
+		ProjectID:         projectID,
+		Name:              name,
+		NewName:           name,
+		IDs:               ids,
+		EnvironmentValues: environmentValues,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetEnvironmentVariable(projectID, name)
+}
+
+func (c *Client) DeleteEnvironmentVariable(projectID int, name string) error {
+	current, err := c.GetEnvironmentVariable(projectID, name)
+	if err != nil {
+		return err
+	}
+
+	ids := []int{}
+	for _, id := range current.IDs {
+		ids = append(ids, id)
+	}
+
+	return c.environmentVariableBulkRequest("DELETE", projectID, environmentVariableBulkRequest{
+		ProjectID: projectID,
+		Name:      name,
+		IDs:       ids,
+	})
+}
+
+func (c *Client) environmentVariableBulkRequest(method string, projectID int, request environmentVariableBulkRequest) error {
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/v3/accounts/%d/projects/%d/environment-variables/bulk/", c.HostURL, c.AccountID, projectID), strings.NewReader(string(requestData)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) deleteEnvironmentVariableValue(projectID int, id int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/environment-variables/%d/", c.HostURL, c.AccountID, projectID, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const (
+	EnvironmentVariableTypeJob = "job"
+)
+
+type EnvironmentVariableJobOverride struct {
+	ID              *int   `json:"id,omitempty"`
+	AccountID       int    `json:"account_id"`
+	ProjectID       int    `json:"project_id"`
+	JobDefinitionID int    `json:"job_definition_id"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	RawValue        string `json:"raw_value"`
+}
+
+type EnvironmentVariableJobOverrideResponse struct {
+	Data   EnvironmentVariableJobOverride `json:"data"`
+	Status ResponseStatus                 `json:"status"`
+}
+
+// EnvironmentVariableValue is the value of an environment variable at one
+// level (project, environment or job) of the hierarchy
+type EnvironmentVariableValue struct {
+	ID    *int   `json:"id"`
+	Value string `json:"value"`
+}
+
+// The job listing returns, for each variable name, its value at every level
+// keyed by level ("project", environment names and "job")
+type EnvironmentVariableJobListResponse struct {
+	Data   map[string]map[string]EnvironmentVariableValue `json:"data"`
+	Status ResponseStatus                                 `json:"status"`
+}
+
+func (c *Client) GetEnvironmentVariableJobOverride(projectID int, jobID int, name string) (*EnvironmentVariableJobOverride, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/environment-variables/job/?job_definition_id=%d", c.HostURL, c.AccountID, projectID, jobID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	listResponse := EnvironmentVariableJobListResponse{}
+	err = json.Unmarshal(body, &listResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	values, ok := listResponse.Data[name]
+	if !ok {
+		return nil, fmt.Errorf("environment variable %s %w for job ID %d", name, ErrNotFound, jobID)
+	}
+	override, ok := values[EnvironmentVariableTypeJob]
+	if !ok || override.ID == nil {
+		return nil, fmt.Errorf("job override of environment variable %s %w for job ID %d", name, ErrNotFound, jobID)
+	}
+
+	return &EnvironmentVariableJobOverride{
+		ID:              override.ID,
+		AccountID:       c.AccountID,
+		ProjectID:       projectID,
+		JobDefinitionID: jobID,
+		Name:            name,
+		Type:            EnvironmentVariableTypeJob,
+		RawValue:        override.Value,
+	}, nil
+}
+
+func (c *Client) CreateEnvironmentVariableJobOverride(override *EnvironmentVariableJobOverride) (*EnvironmentVariableJobOverride, error) {
+	override.AccountID = c.AccountID
+	override.Type = EnvironmentVariableTypeJob
+
+	return c.createUpdateEnvironmentVariableJobOverride(override, fmt.Sprintf("%s/v3/accounts/%d/projects/%d/environment-variables/", c.HostURL, c.AccountID, override.ProjectID))
+}
+
+func (c *Client) UpdateEnvironmentVariableJobOverride(override *EnvironmentVariableJobOverride) (*EnvironmentVariableJobOverride, error) {
+	override.AccountID = c.AccountID
+	override.Type = EnvironmentVariableTypeJob
+
+	return c.createUpdateEnvironmentVariableJobOverride(override, fmt.Sprintf("%s/v3/accounts/%d/projects/%d/environment-variables/%d/", c.HostURL, c.AccountID, override.ProjectID, *override.ID))
+}
+
+func (c *Client) createUpdateEnvironmentVariableJobOverride(override *EnvironmentVariableJobOverride, url string) (*EnvironmentVariableJobOverride, error) {
+	overrideData, err := json.Marshal(override)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Environment variable job override POST (url: %s)", url)
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(overrideData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	overrideResponse := EnvironmentVariableJobOverrideResponse{}
+	err = json.Unmarshal(body, &overrideResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &overrideResponse.Data, nil
+}
+
+func (c *Client) DeleteEnvironmentVariableJobOverride(projectID int, overrideID int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/environment-variables/%d/", c.HostURL, c.AccountID, projectID, overrideID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
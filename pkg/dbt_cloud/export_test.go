@@ -0,0 +1,11 @@
+package dbt_cloud
+
+import "testing"
+
+// AllowGitFileProtocol lets BranchExists reach file:// remotes until the end
+// of the test
+func AllowGitFileProtocol(t *testing.T) {
+	allowProtocol := gitAllowProtocol
+	gitAllowProtocol += ":file"
+	t.Cleanup(func() { gitAllowProtocol = allowProtocol })
+}
@@ -0,0 +1,182 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ExtendedAttributes overrides fields of the profiles.yml of the environments
+// it is attached to
+type ExtendedAttributes struct {
+	ID                 *int            `json:"id,omitempty"`
+	State              int             `json:"state"`
+	AccountID          int             `json:"account_id"`
+	ProjectID          int             `json:"project_id"`
+	ExtendedAttributes json.RawMessage `json:"extended_attributes"`
+}
+
+type ExtendedAttributesResponse struct {
+	Data   ExtendedAttributes `json:"data"`
+	Status ResponseStatus     `json:"status"`
+}
+
+// ParseExtendedAttributes parses a YAML or JSON document (JSON being valid
+// YAML) that must be a flat mapping of profile fields to scalar values
+func ParseExtendedAttributes(document string) (map[string]interface{}, error) {
+	parsed := yaml.MapSlice{}
+	if err := yaml.Unmarshal([]byte(document), &parsed); err != nil {
+		return nil, fmt.Errorf("extended attributes must be a YAML or JSON mapping: %s", err)
+	}
+
+	attributes := map[string]interface{}{}
+	for _, item := range parsed {
+		key, ok := item.Key.(string)
+		if !ok {
+			return nil, fmt.Errorf("extended attribute keys must be strings, got %v", item.Key)
+		}
+		if _, ok := attributes[key]; ok {
+			return nil, fmt.Errorf("extended attribute %s is set more than once", key)
+		}
+		switch item.Value.(type) {
+		case yaml.MapSlice, map[interface{}]interface{}, []interface{}:
+			return nil, fmt.Errorf("extended attribute %s must be a scalar value, extended attributes are a flat mapping", key)
+		}
+		attributes[key] = item.Value
+	}
+
+	return attributes, nil
+}
+
+// NormalizeExtendedAttributes returns the canonical JSON of an extended
+// attributes document, with sorted keys and numbers written the same way
+// whatever the input format
+func NormalizeExtendedAttributes(document string) (string, error) {
+	attributes, err := ParseExtendedAttributes(document)
+	if err != nil {
+		return "", err
+	}
+
+	normalized, err := json.Marshal(attributes)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+// ExtendedAttributesEqual returns whether two documents define the same
+// attributes, however they are written
+func ExtendedAttributesEqual(a string, b string) bool {
+	if strings.TrimSpace(a) == "" || strings.TrimSpace(b) == "" {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+
+	normalizedA, err := NormalizeExtendedAttributes(a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := NormalizeExtendedAttributes(b)
+	if err != nil {
+		return false
+	}
+	return normalizedA == normalizedB
+}
+
+func (c *Client) GetExtendedAttributes(projectID int, extendedAttributesID int) (*ExtendedAttributes, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectID, extendedAttributesID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedAttributesResponse := ExtendedAttributesResponse{}
+	err = json.Unmarshal(body, &extendedAttributesResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if extendedAttributesResponse.Data.State == STATE_DELETED {
+		return nil, fmt.Errorf("extended attributes %d %w in project ID %d", extendedAttributesID, ErrNotFound, projectID)
+	}
+
+	return &extendedAttributesResponse.Data, nil
+}
+
+func (c *Client) CreateExtendedAttributes(projectID int, document string) (*ExtendedAttributes, error) {
+	normalized, err := NormalizeExtendedAttributes(document)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedAttributes := ExtendedAttributes{
+		State:              STATE_ACTIVE,
+		AccountID:          c.AccountID,
+		ProjectID:          projectID,
+		ExtendedAttributes: json.RawMessage(normalized),
+	}
+
+	return c.saveExtendedAttributes(extendedAttributes, fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/", c.HostURL, c.AccountID, projectID))
+}
+
+func (c *Client) UpdateExtendedAttributes(projectID int, extendedAttributesID int, document string) (*ExtendedAttributes, error) {
+	normalized, err := NormalizeExtendedAttributes(document)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedAttributes := ExtendedAttributes{
+		ID:                 &extendedAttributesID,
+		State:              STATE_ACTIVE,
+		AccountID:          c.AccountID,
+		ProjectID:          projectID,
+		ExtendedAttributes: json.RawMessage(normalized),
+	}
+
+	return c.saveExtendedAttributes(extendedAttributes, fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectID, extendedAttributesID))
+}
+
+func (c *Client) saveExtendedAttributes(extendedAttributes ExtendedAttributes, url string) (*ExtendedAttributes, error) {
+	extendedAttributesData, err := json.Marshal(extendedAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(extendedAttributesData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedAttributesResponse := ExtendedAttributesResponse{}
+	err = json.Unmarshal(body, &extendedAttributesResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extendedAttributesResponse.Data, nil
+}
+
+func (c *Client) DeleteExtendedAttributes(projectID int, extendedAttributesID int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectID, extendedAttributesID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
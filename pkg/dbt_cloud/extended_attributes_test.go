@@ -0,0 +1,55 @@
+package dbt_cloud_test
+
+import (
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestParseExtendedAttributes(t *testing.T) {
+	valid := []string{
+		`{"query_tag": "dbt", "threads": 8}`,
+		"query_tag: dbt\nthreads: 8\n",
+		"",
+	}
+	for _, document := range valid {
+		if _, err := dbt_cloud.ParseExtendedAttributes(document); err != nil {
+			t.Errorf("expected %q to be valid, got %s", document, err)
+		}
+	}
+
+	invalid := []string{
+		`["query_tag"]`,
+		"just a string",
+		`{"outputs": {"prod": {"schema": "x"}}}`,
+		"keepalives_idle: [1, 2]",
+		"query_tag: a\nquery_tag: b\n",
+		`{"query_tag": "dbt"`,
+	}
+	for _, document := range invalid {
+		if _, err := dbt_cloud.ParseExtendedAttributes(document); err == nil {
+			t.Errorf("expected %q to be invalid", document)
+		}
+	}
+}
+
+func TestExtendedAttributesEqual(t *testing.T) {
+	cases := []struct {
+		a, b  string
+		equal bool
+	}{
+		{`{"query_tag": "dbt", "threads": 8}`, "threads: 8\nquery_tag: dbt\n", true},
+		{`{"threads": 8}`, `{"threads": 8.0}`, true},
+		{`{"threads": 8}`, `{ "threads" : 8 }`, true},
+		{`{"threads": 8}`, `{"threads": "8"}`, false},
+		{`{"threads": 8}`, `{"threads": 8, "priority": "batch"}`, false},
+		{"", "", true},
+		{"", "{}", false},
+	}
+
+	for _, c := range cases {
+		if actual := dbt_cloud.ExtendedAttributesEqual(c.a, c.b); actual != c.equal {
+			t.Errorf("%q and %q: expected equal %t, got %t", c.a, c.b, c.equal, actual)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package dbt_cloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// ValidateBranchName checks a branch name follows the git reference naming
+// rules, see git check-ref-format
+func ValidateBranchName(branch string) error {
+	if branch == "" {
+		return fmt.Errorf("branch name can't be empty")
+	}
+	if strings.HasPrefix(branch, "-") || strings.HasPrefix(branch, "/") || strings.HasSuffix(branch, "/") {
+		return fmt.Errorf("branch name %q can't start with - or /, or end with /", branch)
+	}
+	if strings.HasSuffix(branch, ".") || strings.HasSuffix(branch, ".lock") {
+		return fmt.Errorf("branch name %q can't end with . or .lock", branch)
+	}
+	if branch == "@" || strings.Contains(branch, "..") || strings.Contains(branch, "//") || strings.Contains(branch, "@{") {
+		return fmt.Errorf("branch name %q can't be @ or contain .., // or @{", branch)
+	}
+	for _, component := range strings.Split(branch, "/") {
+		if strings.HasPrefix(component, ".") {
+			return fmt.Errorf("branch name %q can't have components starting with .", branch)
+		}
+	}
+	for _, r := range branch {
+		if r < 32 || r == 127 || strings.ContainsRune(" ~^:?*[\\", r) {
+			return fmt.Errorf("branch name %q can't contain spaces, control characters or any of ~^:?*[\\", branch)
+		}
+	}
+	return nil
+}
+
+// Transports git may use to reach the remote of a repository, other ones
+// like ext:: can run commands
+var gitAllowProtocol = "https:ssh:git"
+
+// BranchExists checks whether a branch exists in a git remote, using git
+// ls-remote with the credentials git has locally
+func BranchExists(ctx context.Context, remoteURL string, branch string) (bool, error) {
+	if strings.HasPrefix(remoteURL, "-") {
+		return false, fmt.Errorf("invalid git remote %q", remoteURL)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "ls-remote", "--heads", "--exit-code", "--", remoteURL, "refs/heads/"+branch)
+	// Fail instead of waiting for credentials to be typed in
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_ALLOW_PROTOCOL="+gitAllowProtocol)
+	stderr := bytes.Buffer{}
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err == nil {
+		return true, nil
+	}
+
+	// git ls-remote --exit-code exits with 2 when no reference matched
+	exitErr := &exec.ExitError{}
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 2 {
+		return false, nil
+	}
+	return false, fmt.Errorf("listing branches of %s failed: %s %s", remoteURL, err, strings.TrimSpace(stderr.String()))
+}
@@ -0,0 +1,98 @@
+package dbt_cloud_test
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestValidateBranchName(t *testing.T) {
+	valid := []string{"main", "feature/new-models", "release-1.0", "user@feature"}
+	for _, branch := range valid {
+		if err := dbt_cloud.ValidateBranchName(branch); err != nil {
+			t.Errorf("expected %q to be valid, got %s", branch, err)
+		}
+	}
+
+	invalid := []string{"", "-main", "/main", "main/", "main.", "main.lock", "feature..x", "feature//x", "a@{b", "@", "feature/.hidden", "new branch", "main~1", "main:x", "what?", "ma*n", "[main", "a\\b"}
+	for _, branch := range invalid {
+		if err := dbt_cloud.ValidateBranchName(branch); err == nil {
+			t.Errorf("expected %q to be invalid", branch)
+		}
+	}
+}
+
+func git(t *testing.T, dir string, args ...string) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %s %s", args, err, output)
+	}
+}
+
+// gitRemote sets up a bare repository with the given branches, served over
+// the file:// transport
+func gitRemote(t *testing.T, branches ...string) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	root := t.TempDir()
+	remote := filepath.Join(root, "remote.git")
+	work := filepath.Join(root, "work")
+
+	git(t, root, "init", "--bare", remote)
+	git(t, root, "init", work)
+	git(t, work, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "initial")
+	for _, branch := range branches {
+		git(t, work, "push", remote, "HEAD:refs/heads/"+branch)
+	}
+
+	return "file://" + remote
+}
+
+func TestBranchExists(t *testing.T) {
+	remote := gitRemote(t, "main", "feature/new-models")
+	dbt_cloud.AllowGitFileProtocol(t)
+
+	for branch, expected := range map[string]bool{
+		"main":               true,
+		"feature/new-models": true,
+		"feature":            false,
+		"develop":            false,
+	} {
+		exists, err := dbt_cloud.BranchExists(context.Background(), remote, branch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists != expected {
+			t.Errorf("expected branch %s to exist: %t, got %t", branch, expected, exists)
+		}
+	}
+}
+
+func TestBranchExistsUnreachableRemote(t *testing.T) {
+	remote := gitRemote(t)
+	dbt_cloud.AllowGitFileProtocol(t)
+
+	_, err := dbt_cloud.BranchExists(context.Background(), remote+"-missing", "main")
+	if err == nil {
+		t.Error("expected an error for a remote that doesn't exist")
+	}
+}
+
+func TestBranchExistsRejectedRemotes(t *testing.T) {
+	remote := gitRemote(t, "main")
+
+	for _, remoteURL := range []string{
+		remote,
+		"--upload-pack=touch /tmp/pwned",
+		"ext::sh -c touch% /tmp/pwned",
+	} {
+		if _, err := dbt_cloud.BranchExists(context.Background(), remoteURL, "main"); err == nil {
+			t.Errorf("expected remote %q to be rejected", remoteURL)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Permission sets that can be granted to groups
+var PermissionSets = []string{
+	"owner",
+	"member",
+	"account_admin",
+	"security_admin",
+	"billing_admin",
+	"admin",
+	"database_admin",
+	"git_admin",
+	"team_admin",
+	"job_admin",
+	"job_viewer",
+	"analyst",
+	"developer",
+	"stakeholder",
+	"readonly",
+	"project_creator",
+	"account_viewer",
+	"metadata_only",
+	"semantic_layer_only",
+	"webhooks_only",
+}
+
+type GroupPermission struct {
+	ID            *int   `json:"id,omitempty"`
+	AccountID     int    `json:"account_id"`
+	GroupID       int    `json:"group_id"`
+	ProjectID     *int   `json:"project_id"`
+	AllProjects   bool   `json:"all_projects"`
+	State         int    `json:"state"`
+	PermissionSet string `json:"permission_set"`
+}
+
+type Group struct {
+	ID               *int              `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	AccountID        int               `json:"account_id"`
+	State            int               `json:"state"`
+	AssignByDefault  bool              `json:"assign_by_default"`
+	SSOMappingGroups []string          `json:"sso_mapping_groups"`
+	Permissions      []GroupPermission `json:"group_permissions,omitempty"`
+}
+
+type GroupResponse struct {
+	Data   Group          `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+type GroupPermissionListResponse struct {
+	Data   []GroupPermission `json:"data"`
+	Status ResponseStatus    `json:"status"`
+}
+
+func (c *Client) GetGroup(groupID int) (*Group, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/groups/%d/", c.HostURL, c.AccountID, groupID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	groupResponse := GroupResponse{}
+	err = json.Unmarshal(body, &groupResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if groupResponse.Data.State == STATE_DELETED {
+		return nil, fmt.Errorf("group %d %w", groupID, ErrNotFound)
+	}
+
+	return &groupResponse.Data, nil
+}
+
+func (c *Client) CreateGroup(name string, assignByDefault bool, ssoMappingGroups []string) (*Group, error) {
+	group := Group{
+		Name:             name,
+		AccountID:        c.AccountID,
+		State:            STATE_ACTIVE,
+		AssignByDefault:  assignByDefault,
+		SSOMappingGroups: ssoMappingGroups,
+	}
+
+	return c.saveGroup(group, fmt.Sprintf("%s/v3/accounts/%d/groups/", c.HostURL, c.AccountID))
+}
+
+func (c *Client) UpdateGroup(groupID int, group Group) (*Group, error) {
+	// Permissions are only saved through UpdateGroupPermissions
+	group.Permissions = nil
+
+	return c.saveGroup(group, fmt.Sprintf("%s/v3/accounts/%d/groups/%d/", c.HostURL, c.AccountID, groupID))
+}
+
+func (c *Client) saveGroup(group Group, url string) (*Group, error) {
+	if group.SSOMappingGroups == nil {
+		group.SSOMappingGroups = []string{}
+	}
+
+	groupData, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(groupData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	groupResponse := GroupResponse{}
+	err = json.Unmarshal(body, &groupResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupResponse.Data, nil
+}
+
+// UpdateGroupPermissions replaces all the permissions of a group
+func (c *Client) UpdateGroupPermissions(groupID int, permissions []GroupPermission) ([]GroupPermission, error) {
+	for i := range permissions {
+		permissions[i].AccountID = c.AccountID
+		permissions[i].GroupID = groupID
+		permissions[i].State = STATE_ACTIVE
+	}
+	if permissions == nil {
+		permissions = []GroupPermission{}
+	}
+
+	permissionsData, err := json.Marshal(permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%d/group-permissions/%d/", c.HostURL, c.AccountID, groupID), strings.NewReader(string(permissionsData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPermissionListResponse := GroupPermissionListResponse{}
+	err = json.Unmarshal(body, &groupPermissionListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupPermissionListResponse.Data, nil
+}
+
+// DeleteGroup deactivates a group, which is how dbt Cloud deletes them
+func (c *Client) DeleteGroup(groupID int) error {
+	group, err := c.GetGroup(groupID)
+	if err != nil {
+		return err
+	}
+
+	group.State = STATE_DELETED
+	_, err = c.UpdateGroup(groupID, *group)
+	return err
+}
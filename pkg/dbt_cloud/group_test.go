@@ -0,0 +1,66 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestGetGroupDeleted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/accounts/1/groups/5/" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"data": {"id": 5, "state": 2, "name": "Analysts"}}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	if _, err := c.GetGroup(5); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestUpdateGroupPermissions(t *testing.T) {
+	var sent []dbt_cloud.GroupPermission
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v3/accounts/1/group-permissions/5/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &sent); err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(`{"data": ` + string(body) + `}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	projectId := 2
+	permissions, err := c.UpdateGroupPermissions(5, []dbt_cloud.GroupPermission{
+		{PermissionSet: "developer", ProjectID: &projectId},
+		{PermissionSet: "job_viewer", AllProjects: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sent) != 2 || len(permissions) != 2 {
+		t.Fatalf("expected 2 permissions, sent %d and got back %d", len(sent), len(permissions))
+	}
+	for _, permission := range sent {
+		if permission.AccountID != 1 || permission.GroupID != 5 || permission.State != dbt_cloud.STATE_ACTIVE {
+			t.Errorf("expected permissions of group 5 in account 1, got %+v", permission)
+		}
+	}
+	if *sent[0].ProjectID != 2 || sent[0].AllProjects || sent[1].ProjectID != nil || !sent[1].AllProjects {
+		t.Errorf("unexpected permission scopes %+v", sent)
+	}
+}
@@ -0,0 +1,240 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const (
+	IP_RESTRICTIONS_TYPE_ALLOW = 1
+	IP_RESTRICTIONS_TYPE_DENY  = 2
+)
+
+// IPRestrictionsTypes maps the rule types used in the provider to the ones
+// of the API
+var IPRestrictionsTypes = map[string]int{
+	"allow": IP_RESTRICTIONS_TYPE_ALLOW,
+	"deny":  IP_RESTRICTIONS_TYPE_DENY,
+}
+
+// Service returning the public IP of the caller, used when Client.CallerIP
+// isn't set and Client.DetectCallerIP is
+const CallerIPURL string = "https://checkip.amazonaws.com"
+
+// ErrUnknownCallerIP is returned by GetCallerIP when the caller IP is neither
+// set nor detected
+var ErrUnknownCallerIP = errors.New("the IP Terraform calls dbt Cloud from is unknown")
+
+type IPRestrictionsCIDR struct {
+	ID          *int   `json:"id,omitempty"`
+	CIDR        string `json:"cidr"`
+	Description string `json:"description"`
+}
+
+type IPRestrictionsRule struct {
+	ID             *int                 `json:"id,omitempty"`
+	AccountID      int                  `json:"account_id"`
+	Name           string               `json:"name"`
+	Description    string               `json:"description"`
+	Type           int                  `json:"type"`
+	CIDRs          []IPRestrictionsCIDR `json:"cidrs"`
+	Exceptions     []IPRestrictionsCIDR `json:"exceptions"`
+	RuleSetEnabled bool                 `json:"rule_set_enabled"`
+}
+
+type IPRestrictionsRuleResponse struct {
+	Data   IPRestrictionsRule `json:"data"`
+	Status ResponseStatus     `json:"status"`
+}
+
+type IPRestrictionsRuleListResponse struct {
+	Data   []IPRestrictionsRule `json:"data"`
+	Status ResponseStatus       `json:"status"`
+}
+
+// Matches returns whether the IP is in a CIDR of the rule and in none of
+// its exceptions
+func (r *IPRestrictionsRule) Matches(ip net.IP) bool {
+	return cidrsContain(r.CIDRs, ip) && !cidrsContain(r.Exceptions, ip)
+}
+
+func cidrsContain(cidrs []IPRestrictionsCIDR, ip net.IP) bool {
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr.CIDR)
+		if err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// IPRestrictionsEnforced returns whether the IP restrictions of the account
+// are enforced. rule_set_enabled is a setting of the whole rule set, enabling
+// it on any rule enforces all the rules of the account.
+func IPRestrictionsEnforced(rules []IPRestrictionsRule) bool {
+	for _, rule := range rules {
+		if rule.RuleSetEnabled {
+			return true
+		}
+	}
+	return false
+}
+
+// IPRestrictionsExclude returns whether the rules keep the IP from calling
+// dbt Cloud: they're enforced, and it matches a deny rule, or there are
+// allow rules and it matches none of them
+func IPRestrictionsExclude(rules []IPRestrictionsRule, ip net.IP) bool {
+	if !IPRestrictionsEnforced(rules) {
+		return false
+	}
+
+	hasAllowRules := false
+	allowed := false
+	for i, rule := range rules {
+		switch rule.Type {
+		case IP_RESTRICTIONS_TYPE_DENY:
+			if rules[i].Matches(ip) {
+				return true
+			}
+		case IP_RESTRICTIONS_TYPE_ALLOW:
+			hasAllowRules = true
+			allowed = allowed || rules[i].Matches(ip)
+		}
+	}
+	return hasAllowRules && !allowed
+}
+
+func (c *Client) GetIPRestrictionsRule(ruleID int) (*IPRestrictionsRule, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/ip-restrictions/%d/", c.HostURL, c.AccountID, ruleID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleResponse := IPRestrictionsRuleResponse{}
+	err = json.Unmarshal(body, &ruleResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ruleResponse.Data, nil
+}
+
+func (c *Client) ListIPRestrictionsRules() ([]IPRestrictionsRule, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/ip-restrictions/", c.HostURL, c.AccountID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleListResponse := IPRestrictionsRuleListResponse{}
+	err = json.Unmarshal(body, &ruleListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return ruleListResponse.Data, nil
+}
+
+func (c *Client) CreateIPRestrictionsRule(rule IPRestrictionsRule) (*IPRestrictionsRule, error) {
+	rule.AccountID = c.AccountID
+
+	return c.saveIPRestrictionsRule(rule, "POST", fmt.Sprintf("%s/v3/accounts/%d/ip-restrictions/", c.HostURL, c.AccountID))
+}
+
+func (c *Client) UpdateIPRestrictionsRule(ruleID int, rule IPRestrictionsRule) (*IPRestrictionsRule, error) {
+	return c.saveIPRestrictionsRule(rule, "PUT", fmt.Sprintf("%s/v3/accounts/%d/ip-restrictions/%d/", c.HostURL, c.AccountID, ruleID))
+}
+
+func (c *Client) saveIPRestrictionsRule(rule IPRestrictionsRule, method string, url string) (*IPRestrictionsRule, error) {
+	if rule.CIDRs == nil {
+		rule.CIDRs = []IPRestrictionsCIDR{}
+	}
+	if rule.Exceptions == nil {
+		rule.Exceptions = []IPRestrictionsCIDR{}
+	}
+
+	ruleData, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, url, strings.NewReader(string(ruleData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleResponse := IPRestrictionsRuleResponse{}
+	err = json.Unmarshal(body, &ruleResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ruleResponse.Data, nil
+}
+
+func (c *Client) DeleteIPRestrictionsRule(ruleID int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/ip-restrictions/%d/", c.HostURL, c.AccountID, ruleID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetCallerIP returns the public IP the client calls dbt Cloud from,
+// Client.CallerIP if set, or else as seen by CallerIPURL when
+// Client.DetectCallerIP is set
+func (c *Client) GetCallerIP() (net.IP, error) {
+	callerIP := c.CallerIP
+	if callerIP == "" {
+		if !c.DetectCallerIP {
+			return nil, ErrUnknownCallerIP
+		}
+
+		// Not doRequest, the token must not be sent to another service
+		res, err := c.HTTPClient.Get(CallerIPURL)
+		if err != nil {
+			return nil, fmt.Errorf("can't get the IP Terraform calls dbt Cloud from: %s", err)
+		}
+		defer res.Body.Close()
+
+		body, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("can't get the IP Terraform calls dbt Cloud from, %s status: %d", CallerIPURL, res.StatusCode)
+		}
+		callerIP = strings.TrimSpace(string(body))
+	}
+
+	ip := net.ParseIP(callerIP)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid caller IP %q", callerIP)
+	}
+	return ip, nil
+}
@@ -0,0 +1,111 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestIPRestrictionsExclude(t *testing.T) {
+	office := dbt_cloud.IPRestrictionsRule{
+		Type:           dbt_cloud.IP_RESTRICTIONS_TYPE_ALLOW,
+		CIDRs:          []dbt_cloud.IPRestrictionsCIDR{{CIDR: "203.0.113.0/24"}, {CIDR: "2001:db8::/32"}},
+		Exceptions:     []dbt_cloud.IPRestrictionsCIDR{{CIDR: "203.0.113.128/25"}},
+		RuleSetEnabled: true,
+	}
+	vpn := dbt_cloud.IPRestrictionsRule{
+		Type:           dbt_cloud.IP_RESTRICTIONS_TYPE_ALLOW,
+		CIDRs:          []dbt_cloud.IPRestrictionsCIDR{{CIDR: "198.51.100.7/32"}},
+		RuleSetEnabled: true,
+	}
+	blocked := dbt_cloud.IPRestrictionsRule{
+		Type:           dbt_cloud.IP_RESTRICTIONS_TYPE_DENY,
+		CIDRs:          []dbt_cloud.IPRestrictionsCIDR{{CIDR: "203.0.113.0/28"}},
+		RuleSetEnabled: true,
+	}
+	disabled := vpn
+	disabled.RuleSetEnabled = false
+
+	for _, test := range []struct {
+		name     string
+		rules    []dbt_cloud.IPRestrictionsRule
+		ip       string
+		excluded bool
+	}{
+		{"no rules", nil, "192.0.2.1", false},
+		{"allowed", []dbt_cloud.IPRestrictionsRule{office}, "203.0.113.20", false},
+		{"allowed IPv6", []dbt_cloud.IPRestrictionsRule{office}, "2001:db8::1", false},
+		{"not allowed", []dbt_cloud.IPRestrictionsRule{office}, "192.0.2.1", true},
+		{"exception of an allow rule", []dbt_cloud.IPRestrictionsRule{office}, "203.0.113.200", true},
+		{"allowed by another rule", []dbt_cloud.IPRestrictionsRule{office, vpn}, "198.51.100.7", false},
+		{"denied", []dbt_cloud.IPRestrictionsRule{office, blocked}, "203.0.113.5", true},
+		{"disabled rule set", []dbt_cloud.IPRestrictionsRule{disabled}, "192.0.2.1", false},
+		{"rule set enabled by another rule", []dbt_cloud.IPRestrictionsRule{disabled, blocked}, "192.0.2.1", true},
+	} {
+		if excluded := dbt_cloud.IPRestrictionsExclude(test.rules, net.ParseIP(test.ip)); excluded != test.excluded {
+			t.Errorf("%s: expected %s to be excluded %t, got %t", test.name, test.ip, test.excluded, excluded)
+		}
+	}
+}
+
+func TestCreateIPRestrictionsRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v3/accounts/1/ip-restrictions/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		rule := dbt_cloud.IPRestrictionsRule{}
+		if err := json.Unmarshal(body, &rule); err != nil {
+			t.Fatal(err)
+		}
+		if rule.AccountID != 1 || rule.Type != dbt_cloud.IP_RESTRICTIONS_TYPE_DENY || rule.Exceptions == nil {
+			t.Errorf("unexpected rule %s", body)
+		}
+		id := 6
+		rule.ID = &id
+		json.NewEncoder(w).Encode(dbt_cloud.IPRestrictionsRuleResponse{Data: rule})
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	rule, err := c.CreateIPRestrictionsRule(dbt_cloud.IPRestrictionsRule{
+		Name:  "blocked",
+		Type:  dbt_cloud.IP_RESTRICTIONS_TYPE_DENY,
+		CIDRs: []dbt_cloud.IPRestrictionsCIDR{{CIDR: "203.0.113.0/28", Description: "Guest wifi"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *rule.ID != 6 || rule.CIDRs[0].Description != "Guest wifi" {
+		t.Errorf("unexpected rule %+v", rule)
+	}
+}
+
+func TestGetCallerIP(t *testing.T) {
+	c := dbt_cloud.Client{CallerIP: "203.0.113.20"}
+	ip, err := c.GetCallerIP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ip.Equal(net.ParseIP("203.0.113.20")) {
+		t.Errorf("unexpected caller IP %s", ip)
+	}
+
+	c.CallerIP = "not an IP"
+	if _, err := c.GetCallerIP(); err == nil {
+		t.Error("expected an error for an invalid caller IP")
+	}
+
+	// Only detected when asked to
+	c.CallerIP = ""
+	if _, err := c.GetCallerIP(); !errors.Is(err, dbt_cloud.ErrUnknownCallerIP) {
+		t.Errorf("expected the caller IP to be unknown, got %v", err)
+	}
+}
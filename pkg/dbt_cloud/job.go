@@ -43,6 +43,11 @@ type JobResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
+type JobListResponse struct {
+	Data   []Job          `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
 type Job struct {
 	ID                   *int        `json:"id"`
 	Account_Id           int         `json:"account_id"`
@@ -79,6 +84,51 @@ func (c *Client) GetJob(jobID string) (*Job, error) {
 	return &jobResponse.Data, nil
 }
 
+// ListJobs returns the jobs of a project
+func (c *Client) ListJobs(projectId int) ([]Job, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%d/jobs/?project_id=%d", c.HostURL, c.AccountID, projectId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	jobListResponse := JobListResponse{}
+	err = json.Unmarshal(body, &jobListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobListResponse.Data, nil
+}
+
+// GetJobByName returns the active job of a project with the given name, or
+// an error when there isn't exactly one
+func (c *Client) GetJobByName(projectId int, name string) (*Job, error) {
+	jobs, err := c.ListJobs(projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Job
+	for i, job := range jobs {
+		if job.State != STATE_ACTIVE || job.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("found several jobs named %q in project ID %d, use job IDs instead", name, projectId)
+		}
+		found = &jobs[i]
+	}
+	if found == nil {
+		return nil, fmt.Errorf("job named %q %w in project ID %d", name, ErrNotFound, projectId)
+	}
+	return found, nil
+}
+
 func (c *Client) CreateJob(projectId int, environmentId int, name string, executeSteps []string, dbtVersion string, isActive bool, triggers map[string]interface{}, numThreads int, targetName string, generateDocs bool, runGenerateSources bool, scheduleType string, scheduleInterval int, scheduleHours []int, scheduleDays []int, scheduleCron string) (*Job, error) {
 	state := 1
 	if !isActive {
@@ -201,3 +251,19 @@ func (c *Client) UpdateJob(jobId string, job Job) (*Job, error) {
 
 	return &jobResponse.Data, nil
 }
+
+// CheckAccountJob returns an error if the job isn't an active job of the
+// account of the client, deleted jobs are inactive too
+func (c *Client) CheckAccountJob(jobID int) error {
+	job, err := c.GetJob(strconv.Itoa(jobID))
+	if err != nil {
+		return fmt.Errorf("job %d can't be found in account %d: %w", jobID, c.AccountID, err)
+	}
+	if job.Account_Id != c.AccountID {
+		return fmt.Errorf("job %d %w in account %d", jobID, ErrNotFound, c.AccountID)
+	}
+	if job.State != STATE_ACTIVE {
+		return fmt.Errorf("job %d of account %d is not active", jobID, c.AccountID)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package dbt_cloud_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestGetJobByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/accounts/1/jobs/" || r.URL.Query().Get("project_id") != "2" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"data": [
+			{"id": 20, "state": 2, "project_id": 2, "name": "Daily run"},
+			{"id": 21, "state": 1, "project_id": 2, "name": "Daily run"},
+			{"id": 22, "state": 1, "project_id": 2, "name": "Hourly run"}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	job, err := c.GetJobByName(2, "Daily run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *job.ID != 21 {
+		t.Errorf("expected the active job 21, got %d", *job.ID)
+	}
+
+	if _, err := c.GetJobByName(2, "Weekly run"); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestCheckAccountJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/accounts/1/jobs/10/":
+			w.Write([]byte(`{"data": {"id": 10, "account_id": 1, "state": 1}}`))
+		case "/v2/accounts/1/jobs/11/":
+			w.Write([]byte(`{"data": {"id": 11, "account_id": 1, "state": 2}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status": {"code": 404}}`))
+		}
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	if err := c.CheckAccountJob(10); err != nil {
+		t.Errorf("expected job 10 to be found, got %s", err)
+	}
+	if err := c.CheckAccountJob(11); err == nil || errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected job 11 to be inactive, got %v", err)
+	}
+	if err := c.CheckAccountJob(12); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected job 12 not to be found, got %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	LICENSE_TYPE_DEVELOPER = "developer"
+	LICENSE_TYPE_READ_ONLY = "read_only"
+	LICENSE_TYPE_IT        = "it"
+)
+
+var LicenseTypes = []string{
+	LICENSE_TYPE_DEVELOPER,
+	LICENSE_TYPE_READ_ONLY,
+	LICENSE_TYPE_IT,
+}
+
+// LicenseMap gives a license to the users in the SSO groups
+type LicenseMap struct {
+	ID                      *int     `json:"id,omitempty"`
+	AccountID               int      `json:"account_id"`
+	State                   int      `json:"state"`
+	LicenseType             string   `json:"license_type"`
+	SSOLicenseMappingGroups []string `json:"sso_license_mapping_groups"`
+}
+
+type LicenseMapResponse struct {
+	Data   LicenseMap     `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// Seats returns the seats the account has for a license type, false for
+// license types without a seat limit. Accounts report 0 seats when they
+// aren't limited.
+func (a *AuthResponseData) Seats(licenseType string) (int, bool) {
+	seats := 0
+	switch licenseType {
+	case LICENSE_TYPE_DEVELOPER:
+		seats = a.DeveloperSeats
+	case LICENSE_TYPE_READ_ONLY:
+		seats = a.ReadOnlySeats
+	}
+	return seats, seats > 0
+}
+
+// GetAccount returns the details of the account of the client, including
+// its plan and seats
+func (c *Client) GetAccount() (*AuthResponseData, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%d/", c.HostURL, c.AccountID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	authResponse := AuthResponse{}
+	err = json.Unmarshal(body, &authResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authResponse.Data, nil
+}
+
+func (c *Client) GetLicenseMap(licenseMapID int) (*LicenseMap, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/license-maps/%d/", c.HostURL, c.AccountID, licenseMapID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	licenseMapResponse := LicenseMapResponse{}
+	err = json.Unmarshal(body, &licenseMapResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if licenseMapResponse.Data.State == STATE_DELETED {
+		return nil, fmt.Errorf("license map %d %w", licenseMapID, ErrNotFound)
+	}
+
+	return &licenseMapResponse.Data, nil
+}
+
+func (c *Client) CreateLicenseMap(licenseType string, ssoLicenseMappingGroups []string) (*LicenseMap, error) {
+	licenseMap := LicenseMap{
+		AccountID:               c.AccountID,
+		State:                   STATE_ACTIVE,
+		LicenseType:             licenseType,
+		SSOLicenseMappingGroups: ssoLicenseMappingGroups,
+	}
+
+	return c.saveLicenseMap(licenseMap, fmt.Sprintf("%s/v3/accounts/%d/license-maps/", c.HostURL, c.AccountID))
+}
+
+func (c *Client) UpdateLicenseMap(licenseMapID int, licenseMap LicenseMap) (*LicenseMap, error) {
+	return c.saveLicenseMap(licenseMap, fmt.Sprintf("%s/v3/accounts/%d/license-maps/%d/", c.HostURL, c.AccountID, licenseMapID))
+}
+
+func (c *Client) saveLicenseMap(licenseMap LicenseMap, url string) (*LicenseMap, error) {
+	if licenseMap.SSOLicenseMappingGroups == nil {
+		licenseMap.SSOLicenseMappingGroups = []string{}
+	}
+
+	licenseMapData, err := json.Marshal(licenseMap)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(licenseMapData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	licenseMapResponse := LicenseMapResponse{}
+	err = json.Unmarshal(body, &licenseMapResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &licenseMapResponse.Data, nil
+}
+
+func (c *Client) DeleteLicenseMap(licenseMapID int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/license-maps/%d/", c.HostURL, c.AccountID, licenseMapID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package dbt_cloud_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestAccountSeats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/accounts/1/" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"data": {"id": 1, "developer_seats": 10, "read_only_seats": 50}}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	account, err := c.GetAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for licenseType, expected := range map[string]int{dbt_cloud.LICENSE_TYPE_DEVELOPER: 10, dbt_cloud.LICENSE_TYPE_READ_ONLY: 50} {
+		if seats, limited := account.Seats(licenseType); !limited || seats != expected {
+			t.Errorf("expected %d %s seats, got %d (limited: %t)", expected, licenseType, seats, limited)
+		}
+	}
+	if _, limited := account.Seats(dbt_cloud.LICENSE_TYPE_IT); limited {
+		t.Error("expected IT licenses not to be limited")
+	}
+
+	account.ReadOnlySeats = 0
+	if _, limited := account.Seats(dbt_cloud.LICENSE_TYPE_READ_ONLY); limited {
+		t.Error("expected 0 read only seats to mean unlimited")
+	}
+}
+
+func TestCountLicensedUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": [
+			{"id": 1, "permissions": [{"account_id": 1, "state": 1, "license_type": "developer"}]},
+			{"id": 2, "permissions": [{"account_id": 1, "state": 1, "license_type": "developer"}]},
+			{"id": 3, "permissions": [{"account_id": 1, "state": 1, "license_type": "read_only"}, {"account_id": 2, "state": 1, "license_type": "developer"}]},
+			{"id": 4, "permissions": [{"account_id": 1, "state": 2, "license_type": "developer"}]}
+		], "extra": {"pagination": {"count": 4, "total_count": 4}}}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	counts, err := c.CountLicensedUsers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts[dbt_cloud.LICENSE_TYPE_DEVELOPER] != 2 || counts[dbt_cloud.LICENSE_TYPE_READ_ONLY] != 1 {
+		t.Errorf("expected 2 developers and 1 read only user, got %v", counts)
+	}
+}
+
+func TestGetLicenseMapDeleted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/accounts/1/license-maps/6/" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"data": {"id": 6, "state": 2, "license_type": "developer", "sso_license_mapping_groups": ["analytics"]}}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	if _, err := c.GetLicenseMap(6); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
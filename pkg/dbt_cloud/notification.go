@@ -0,0 +1,137 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	NOTIFICATION_TYPE_INTERNAL = 1
+	NOTIFICATION_TYPE_SLACK    = 2
+	NOTIFICATION_TYPE_EXTERNAL = 4
+)
+
+var NotificationTypes = []int{
+	NOTIFICATION_TYPE_INTERNAL,
+	NOTIFICATION_TYPE_SLACK,
+	NOTIFICATION_TYPE_EXTERNAL,
+}
+
+// Notification sends job run results to a target, the user for internal
+// notifications, an email address for external ones or a Slack channel
+type Notification struct {
+	ID               *int    `json:"id,omitempty"`
+	AccountID        int     `json:"account_id"`
+	UserID           int     `json:"user_id"`
+	State            int     `json:"state"`
+	NotificationType int     `json:"type"`
+	OnSuccess        []int   `json:"on_success"`
+	OnFailure        []int   `json:"on_failure"`
+	OnCancel         []int   `json:"on_cancel"`
+	OnWarning        []int   `json:"on_warning"`
+	ExternalEmail    *string `json:"external_email"`
+	SlackChannelID   *string `json:"slack_channel_id"`
+	SlackChannelName *string `json:"slack_channel_name"`
+}
+
+type NotificationResponse struct {
+	Data   Notification   `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// JobIDs returns the IDs of the jobs the notification is sent for, once
+// each
+func (n *Notification) JobIDs() []int {
+	seen := map[int]bool{}
+	jobIDs := []int{}
+	for _, ids := range [][]int{n.OnSuccess, n.OnFailure, n.OnCancel, n.OnWarning} {
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				jobIDs = append(jobIDs, id)
+			}
+		}
+	}
+	return jobIDs
+}
+
+func (c *Client) GetNotification(notificationID int) (*Notification, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%d/notifications/%d/", c.HostURL, c.AccountID, notificationID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationResponse := NotificationResponse{}
+	err = json.Unmarshal(body, &notificationResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if notificationResponse.Data.State == STATE_DELETED {
+		return nil, fmt.Errorf("notification %d %w", notificationID, ErrNotFound)
+	}
+
+	return &notificationResponse.Data, nil
+}
+
+func (c *Client) CreateNotification(notification Notification) (*Notification, error) {
+	notification.AccountID = c.AccountID
+	notification.State = STATE_ACTIVE
+
+	return c.saveNotification(notification, fmt.Sprintf("%s/v2/accounts/%d/notifications/", c.HostURL, c.AccountID))
+}
+
+func (c *Client) UpdateNotification(notificationID int, notification Notification) (*Notification, error) {
+	return c.saveNotification(notification, fmt.Sprintf("%s/v2/accounts/%d/notifications/%d/", c.HostURL, c.AccountID, notificationID))
+}
+
+func (c *Client) saveNotification(notification Notification, url string) (*Notification, error) {
+	for _, ids := range []*[]int{&notification.OnSuccess, &notification.OnFailure, &notification.OnCancel, &notification.OnWarning} {
+		if *ids == nil {
+			*ids = []int{}
+		}
+	}
+
+	notificationData, err := json.Marshal(notification)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(notificationData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationResponse := NotificationResponse{}
+	err = json.Unmarshal(body, &notificationResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notificationResponse.Data, nil
+}
+
+// DeleteNotification marks the notification as deleted, dbt Cloud has no
+// endpoint to delete it
+func (c *Client) DeleteNotification(notificationID int) error {
+	notification, err := c.GetNotification(notificationID)
+	if err != nil {
+		return err
+	}
+
+	notification.State = STATE_DELETED
+	_, err = c.UpdateNotification(notificationID, *notification)
+	return err
+}
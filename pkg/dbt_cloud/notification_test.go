@@ -0,0 +1,63 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestNotificationLifecycle(t *testing.T) {
+	saved := dbt_cloud.Notification{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/accounts/1/notifications/", "POST /v2/accounts/1/notifications/3/":
+			body, _ := ioutil.ReadAll(r.Body)
+			saved = dbt_cloud.Notification{}
+			if err := json.Unmarshal(body, &saved); err != nil {
+				t.Fatal(err)
+			}
+			if saved.OnSuccess == nil || saved.OnCancel == nil {
+				t.Errorf("expected empty job lists to be sent as lists, got %s", body)
+			}
+			id := 3
+			saved.ID = &id
+		case "GET /v2/accounts/1/notifications/3/":
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(dbt_cloud.NotificationResponse{Data: saved})
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	channel := "C0123"
+	notification, err := c.CreateNotification(dbt_cloud.Notification{
+		UserID:           5,
+		NotificationType: dbt_cloud.NOTIFICATION_TYPE_SLACK,
+		OnFailure:        []int{10, 11},
+		OnWarning:        []int{11},
+		SlackChannelID:   &channel,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *notification.ID != 3 || notification.AccountID != 1 || notification.State != dbt_cloud.STATE_ACTIVE {
+		t.Errorf("unexpected notification %+v", notification)
+	}
+	if jobIDs := notification.JobIDs(); len(jobIDs) != 2 || jobIDs[0] != 10 || jobIDs[1] != 11 {
+		t.Errorf("expected jobs 10 and 11, got %v", jobIDs)
+	}
+
+	if err := c.DeleteNotification(3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetNotification(3); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error for a deleted notification, got %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	OAUTH_CONFIGURATION_TYPE_SNOWFLAKE = "snowflake"
+	OAUTH_CONFIGURATION_TYPE_ENTRA     = "entra"
+)
+
+var OAuthConfigurationTypes = []string{
+	OAUTH_CONFIGURATION_TYPE_SNOWFLAKE,
+	OAUTH_CONFIGURATION_TYPE_ENTRA,
+}
+
+// OAuthConfiguration is an OAuth client of the account, which warehouse
+// connections use to authenticate developers
+type OAuthConfiguration struct {
+	ID        *int   `json:"id,omitempty"`
+	AccountID int    `json:"account_id"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	ClientID  string `json:"client_id"`
+	// Never returned by the API
+	ClientSecret string `json:"client_secret,omitempty"`
+	AuthorizeURL string `json:"authorize_url"`
+	TokenURL     string `json:"token_url"`
+	RedirectURI  string `json:"redirect_uri"`
+	// Only for Entra ID
+	ApplicationIDURI string `json:"application_id_uri,omitempty"`
+}
+
+type OAuthConfigurationResponse struct {
+	Data   OAuthConfiguration `json:"data"`
+	Status ResponseStatus     `json:"status"`
+}
+
+func (c *Client) GetOAuthConfiguration(oauthConfigurationID int) (*OAuthConfiguration, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/oauth-configurations/%d/", c.HostURL, c.AccountID, oauthConfigurationID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthConfigurationResponse := OAuthConfigurationResponse{}
+	err = json.Unmarshal(body, &oauthConfigurationResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauthConfigurationResponse.Data, nil
+}
+
+func (c *Client) CreateOAuthConfiguration(oauthConfiguration OAuthConfiguration) (*OAuthConfiguration, error) {
+	oauthConfiguration.AccountID = c.AccountID
+
+	return c.saveOAuthConfiguration(oauthConfiguration, fmt.Sprintf("%s/v3/accounts/%d/oauth-configurations/", c.HostURL, c.AccountID))
+}
+
+// UpdateOAuthConfiguration saves the configuration, keeping the current
+// client secret if ClientSecret is empty
+func (c *Client) UpdateOAuthConfiguration(oauthConfigurationID int, oauthConfiguration OAuthConfiguration) (*OAuthConfiguration, error) {
+	return c.saveOAuthConfiguration(oauthConfiguration, fmt.Sprintf("%s/v3/accounts/%d/oauth-configurations/%d/", c.HostURL, c.AccountID, oauthConfigurationID))
+}
+
+func (c *Client) saveOAuthConfiguration(oauthConfiguration OAuthConfiguration, url string) (*OAuthConfiguration, error) {
+	oauthConfigurationData, err := json.Marshal(oauthConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(oauthConfigurationData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthConfigurationResponse := OAuthConfigurationResponse{}
+	err = json.Unmarshal(body, &oauthConfigurationResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauthConfigurationResponse.Data, nil
+}
+
+func (c *Client) DeleteOAuthConfiguration(oauthConfigurationID int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/oauth-configurations/%d/", c.HostURL, c.AccountID, oauthConfigurationID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
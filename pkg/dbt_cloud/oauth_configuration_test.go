@@ -0,0 +1,73 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestSaveOAuthConfiguration(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.URL.Path+" "+string(body))
+		w.Write([]byte(`{"data": {"id": 8, "account_id": 1, "type": "snowflake", "name": "snowflake", "client_id": "client"}}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	oauthConfiguration, err := c.CreateOAuthConfiguration(dbt_cloud.OAuthConfiguration{
+		Type:         dbt_cloud.OAUTH_CONFIGURATION_TYPE_SNOWFLAKE,
+		Name:         "snowflake",
+		ClientID:     "client",
+		ClientSecret: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *oauthConfiguration.ID != 8 || oauthConfiguration.ClientSecret != "" {
+		t.Errorf("unexpected OAuth configuration %+v", oauthConfiguration)
+	}
+
+	oauthConfiguration.Name = "renamed"
+	if _, err := c.UpdateOAuthConfiguration(8, *oauthConfiguration); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 2 || !strings.HasPrefix(requests[0], "/v3/accounts/1/oauth-configurations/ ") || !strings.HasPrefix(requests[1], "/v3/accounts/1/oauth-configurations/8/ ") {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+	if !strings.Contains(requests[0], `"client_secret":"secret"`) {
+		t.Errorf("expected the secret to be sent on creation, got %s", requests[0])
+	}
+	if strings.Contains(requests[1], "client_secret") {
+		t.Errorf("expected the secret to be kept on update, got %s", requests[1])
+	}
+}
+
+func TestConnectionOAuthDetails(t *testing.T) {
+	connection := dbt_cloud.ConnectionResponse{}
+	err := json.Unmarshal([]byte(`{"data": {"id": 3, "type": "bigquery", "details": {"is_configured_for_oauth": true, "application_id": "client", "oauth_configuration_id": 8}}}`), &connection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !connection.Data.Details.IsConfiguredForOauth || connection.Data.Details.ApplicationID != "client" || *connection.Data.Details.OAuthConfigurationID != 8 {
+		t.Errorf("unexpected connection details %+v", connection.Data.Details)
+	}
+
+	details, err := json.Marshal(dbt_cloud.ConnectionDetails{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"application_id", "application_secret", "oauth_configuration_id"} {
+		if strings.Contains(string(details), key) {
+			t.Errorf("expected %s to be left out of connections without OAuth, got %s", key, details)
+		}
+	}
+}
@@ -49,6 +49,51 @@ func (c *Client) GetProject(projectID string) (*Project, error) {
 	return &projectResponse.Data, nil
 }
 
+// ListProjects returns the projects of the account
+func (c *Client) ListProjects() ([]Project, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/projects/", c.HostURL, c.AccountID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	projectListResponse := ProjectListResponse{}
+	err = json.Unmarshal(body, &projectListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectListResponse.Data, nil
+}
+
+// GetProjectByName returns the active project with the given name, or an
+// error when there isn't exactly one
+func (c *Client) GetProjectByName(name string) (*Project, error) {
+	projects, err := c.ListProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Project
+	for i, project := range projects {
+		if project.State != STATE_ACTIVE || project.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("found several projects named %q, use project IDs instead", name)
+		}
+		found = &projects[i]
+	}
+	if found == nil {
+		return nil, fmt.Errorf("project named %q %w", name, ErrNotFound)
+	}
+	return found, nil
+}
+
 func (c *Client) CreateProject(name string, dbtProjectSubdirectory string, connectionID int, repositoryID int) (*Project, error) {
 	newProject := Project{
 		Name:      name,
@@ -69,7 +114,7 @@ func (c *Client) CreateProject(name string, dbtProjectSubdirectory string, conne
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Creating the project: %s", string(newProjectData))
+	log.Printf("Creating the project: %s", string(newProjectData))
 
 	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/projects/", c.HostURL, strconv.Itoa(c.AccountID)), strings.NewReader(string(newProjectData)))
 	if err != nil {
@@ -94,7 +139,7 @@ func (c *Client) UpdateProject(projectID string, project Project) (*Project, err
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Updating the project: %s", string(projectData))
+	log.Printf("Updating the project: %s", string(projectData))
 
 	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/projects/%s/", c.HostURL, strconv.Itoa(c.AccountID), projectID), strings.NewReader(string(projectData)))
 	if err != nil {
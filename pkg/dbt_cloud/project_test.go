@@ -0,0 +1,43 @@
+package dbt_cloud_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestGetProjectByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/accounts/1/projects/" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"data": [
+			{"id": 2, "state": 2, "name": "Analytics"},
+			{"id": 3, "state": 1, "name": "Analytics"},
+			{"id": 4, "state": 1, "name": "Marketing"},
+			{"id": 5, "state": 1, "name": "Marketing"}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	project, err := c.GetProjectByName("Analytics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *project.ID != 3 {
+		t.Errorf("expected the active project 3, got %d", *project.ID)
+	}
+
+	if _, err := c.GetProjectByName("Finance"); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	if _, err := c.GetProjectByName("Marketing"); err == nil || !strings.Contains(err.Error(), "several projects") {
+		t.Errorf("expected an error for duplicate names, got %v", err)
+	}
+}
@@ -79,7 +79,7 @@ func (c *Client) createUpdateRepository(repository *Repository, url string) (*Re
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Repository Payload: %s (url: %s)", string(repositoryData), url)
+	log.Printf("Repository Payload: %s (url: %s)", string(repositoryData), url)
 
 	req, err := http.NewRequest("POST", url, strings.NewReader(string(repositoryData)))
 	if err != nil {
@@ -100,7 +100,7 @@ func (c *Client) createUpdateRepository(repository *Repository, url string) (*Re
 }
 
 func (c *Client) DeleteRepository(repositoryID int, projectID int) error {
-	log.Println("Repository Destroy (ID: %d)", repositoryID)
+	log.Printf("Repository Destroy (ID: %d)", repositoryID)
 
 	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%s/projects/%d/repositories/%d/", c.HostURL, strconv.Itoa(c.AccountID), projectID, repositoryID), nil)
 	if err != nil {
@@ -0,0 +1,357 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	RUN_STATUS_QUEUED    = 1
+	RUN_STATUS_STARTING  = 2
+	RUN_STATUS_RUNNING   = 3
+	RUN_STATUS_SUCCESS   = 10
+	RUN_STATUS_ERROR     = 20
+	RUN_STATUS_CANCELLED = 30
+)
+
+// RunStatuses maps the lower case status names used in the provider to
+// dbt Cloud run status codes
+var RunStatuses = map[string]int{
+	"queued":    RUN_STATUS_QUEUED,
+	"starting":  RUN_STATUS_STARTING,
+	"running":   RUN_STATUS_RUNNING,
+	"success":   RUN_STATUS_SUCCESS,
+	"error":     RUN_STATUS_ERROR,
+	"cancelled": RUN_STATUS_CANCELLED,
+}
+
+// Number of runs requested per page when listing runs
+const runsPageSize = 100
+
+// Layouts dbt Cloud uses for run timestamps
+var runTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999-07:00",
+	"2006-01-02 15:04:05-07:00",
+	time.RFC3339Nano,
+}
+
+// Related objects requested alongside a run
+var runIncludeRelated = "?include_related=" + url.QueryEscape(`["trigger","run_steps"]`)
+
+type RunTrigger struct {
+	ID                 *int      `json:"id"`
+	Cause              string    `json:"cause"`
+	JobDefinitionID    int       `json:"job_definition_id"`
+	GitBranch          *string   `json:"git_branch"`
+	GitSha             *string   `json:"git_sha"`
+	StepsOverride      *[]string `json:"steps_override"`
+	ThreadsOverride    *int      `json:"threads_override"`
+	TargetNameOverride *string   `json:"target_name_override"`
+}
+
+type RunStep struct {
+	ID                *int    `json:"id"`
+	RunID             int     `json:"run_id"`
+	Index             int     `json:"index"`
+	Name              string  `json:"name"`
+	Status            int     `json:"status"`
+	StatusHumanized   string  `json:"status_humanized"`
+	Duration          string  `json:"duration"`
+	DurationHumanized string  `json:"duration_humanized"`
+	CreatedAt         *string `json:"created_at"`
+	FinishedAt        *string `json:"finished_at"`
+}
+
+type Run struct {
+	ID                *int        `json:"id"`
+	TriggerID         int         `json:"trigger_id"`
+	AccountID         int         `json:"account_id"`
+	ProjectID         int         `json:"project_id"`
+	EnvironmentID     int         `json:"environment_id"`
+	JobDefinitionID   int         `json:"job_definition_id"`
+	Status            int         `json:"status"`
+	StatusHumanized   string      `json:"status_humanized"`
+	StatusMessage     *string     `json:"status_message"`
+	DbtVersion        string      `json:"dbt_version"`
+	GitBranch         *string     `json:"git_branch"`
+	GitSha            *string     `json:"git_sha"`
+	Href              string      `json:"href"`
+	CreatedAt         *string     `json:"created_at"`
+	StartedAt         *string     `json:"started_at"`
+	FinishedAt        *string     `json:"finished_at"`
+	Duration          string      `json:"duration"`
+	DurationHumanized string      `json:"duration_humanized"`
+	InProgress        bool        `json:"in_progress"`
+	IsComplete        bool        `json:"is_complete"`
+	IsSuccess         bool        `json:"is_success"`
+	IsError           bool        `json:"is_error"`
+	IsCancelled       bool        `json:"is_cancelled"`
+	Trigger           *RunTrigger `json:"trigger"`
+	RunSteps          []RunStep   `json:"run_steps"`
+}
+
+type RunResponse struct {
+	Data   Run            `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+type RunListResponse struct {
+	Data   []Run          `json:"data"`
+	Status ResponseStatus `json:"status"`
+	Extra  struct {
+		Pagination struct {
+			Count      int `json:"count"`
+			TotalCount int `json:"total_count"`
+		} `json:"pagination"`
+	} `json:"extra"`
+}
+
+// RunFilter narrows down the runs returned by ListRuns, zero values don't
+// filter
+type RunFilter struct {
+	JobDefinitionID int
+	EnvironmentID   int
+	ProjectID       int
+	Status          int
+	// Any of these statuses, combined with Status when both are set
+	Statuses      []int
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// Oldest runs first instead of newest first
+	Ascending bool
+	// Maximum number of runs to return, 0 returns all of them
+	Limit int
+}
+
+// JobRunOptions is the payload used to trigger a job run
+type JobRunOptions struct {
+	Cause              string    `json:"cause"`
+	GitSha             *string   `json:"git_sha,omitempty"`
+	GitBranch          *string   `json:"git_branch,omitempty"`
+	StepsOverride      *[]string `json:"steps_override,omitempty"`
+	ThreadsOverride    *int      `json:"threads_override,omitempty"`
+	TargetNameOverride *string   `json:"target_name_override,omitempty"`
+}
+
+func (c *Client) TriggerJobRun(jobID int, options JobRunOptions) (*Run, error) {
+	optionsData, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v2/accounts/%d/jobs/%d/run/", c.HostURL, c.AccountID, jobID), strings.NewReader(string(optionsData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	runResponse := RunResponse{}
+	err = json.Unmarshal(body, &runResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runResponse.Data, nil
+}
+
+func (c *Client) GetRun(runID int) (*Run, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%d/runs/%d/%s", c.HostURL, c.AccountID, runID, runIncludeRelated), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	runResponse := RunResponse{}
+	err = json.Unmarshal(body, &runResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runResponse.Data, nil
+}
+
+func (c *Client) CancelRun(runID int) (*Run, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v2/accounts/%d/runs/%d/cancel/", c.HostURL, c.AccountID, runID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	runResponse := RunResponse{}
+	err = json.Unmarshal(body, &runResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runResponse.Data, nil
+}
+
+// ListActiveJobRuns returns the queued, starting and running runs of a job
+func (c *Client) ListActiveJobRuns(jobID int) ([]Run, error) {
+	return c.ListRuns(RunFilter{
+		JobDefinitionID: jobID,
+		Statuses:        []int{RUN_STATUS_QUEUED, RUN_STATUS_STARTING, RUN_STATUS_RUNNING},
+	})
+}
+
+// ListRuns returns the runs of the account matching filter, paginating
+// through the API as needed.
+func (c *Client) ListRuns(filter RunFilter) ([]Run, error) {
+	query := url.Values{}
+	query.Set("include_related", `["trigger","run_steps"]`)
+	query.Set("limit", strconv.Itoa(runsPageSize))
+	query.Set("order_by", "-id")
+	if filter.Ascending {
+		query.Set("order_by", "id")
+	}
+	if filter.JobDefinitionID != 0 {
+		query.Set("job_definition_id", strconv.Itoa(filter.JobDefinitionID))
+	}
+	if filter.EnvironmentID != 0 {
+		query.Set("environment_id", strconv.Itoa(filter.EnvironmentID))
+	}
+	if filter.ProjectID != 0 {
+		query.Set("project_id", strconv.Itoa(filter.ProjectID))
+	}
+	if filter.Status != 0 {
+		query.Set("status", strconv.Itoa(filter.Status))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = strconv.Itoa(status)
+		}
+		query.Set("status__in", "["+strings.Join(statuses, ",")+"]")
+	}
+
+	runs := []Run{}
+	for offset := 0; ; offset += runsPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%d/runs/?%s", c.HostURL, c.AccountID, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		runListResponse := RunListResponse{}
+		err = json.Unmarshal(body, &runListResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, run := range runListResponse.Data {
+			createdAt := run.CreatedAtTime()
+			if createdAt.IsZero() && (!filter.CreatedAfter.IsZero() || !filter.CreatedBefore.IsZero()) {
+				// Can't place the run in the window, and it mustn't end
+				// the listing either
+				continue
+			}
+			if !filter.CreatedAfter.IsZero() && createdAt.Before(filter.CreatedAfter) {
+				// Runs are ordered by ID, so by creation, the rest are out
+				// of the window too when going from newest to oldest
+				if !filter.Ascending {
+					return runs, nil
+				}
+				continue
+			}
+			if !filter.CreatedBefore.IsZero() && !createdAt.Before(filter.CreatedBefore) {
+				if filter.Ascending {
+					return runs, nil
+				}
+				continue
+			}
+
+			runs = append(runs, run)
+			if filter.Limit > 0 && len(runs) >= filter.Limit {
+				return runs, nil
+			}
+		}
+
+		// Some listings don't report a total count, rely on full pages then
+		totalCount := runListResponse.Extra.Pagination.TotalCount
+		if len(runListResponse.Data) < runsPageSize || (totalCount > 0 && offset+runsPageSize >= totalCount) {
+			return runs, nil
+		}
+	}
+}
+
+// CreatedAtTime returns when the run was created, or the zero time if
+// unknown.
+func (r *Run) CreatedAtTime() time.Time {
+	return parseRunTime(r.CreatedAt)
+}
+
+// StartedAtTime returns when the run started, or the zero time if it
+// hasn't.
+func (r *Run) StartedAtTime() time.Time {
+	return parseRunTime(r.StartedAt)
+}
+
+// FinishedAtTime returns when the run finished, or the zero time if it
+// hasn't.
+func (r *Run) FinishedAtTime() time.Time {
+	return parseRunTime(r.FinishedAt)
+}
+
+// DurationSeconds returns how long the run took, or has been going, in
+// seconds.
+func (r *Run) DurationSeconds() int {
+	return parseRunDuration(r.Duration)
+}
+
+// DurationSeconds returns how long the step took in seconds.
+func (s *RunStep) DurationSeconds() int {
+	return parseRunDuration(s.Duration)
+}
+
+func parseRunTime(value *string) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+	for _, layout := range runTimeLayouts {
+		if t, err := time.Parse(layout, *value); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
+
+// parseRunDuration converts dbt Cloud's HH:MM:SS durations into seconds.
+func parseRunDuration(duration string) int {
+	seconds := 0
+	for _, part := range strings.Split(duration, ":") {
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return 0
+		}
+		seconds = seconds*60 + value
+	}
+	return seconds
+}
+
+// IsRunTerminal returns whether a run status is final
+func IsRunTerminal(status int) bool {
+	return status == RUN_STATUS_SUCCESS || status == RUN_STATUS_ERROR || status == RUN_STATUS_CANCELLED
+}
@@ -0,0 +1,186 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+// runsServer serves total runs of job 1, newest first, one created every hour
+// before 2021-11-05 12:00 UTC, and counts the pages requested.
+func runsServer(t *testing.T, total int, pages *int) *httptest.Server {
+	latest := time.Date(2021, 11, 5, 12, 0, 0, 0, time.UTC)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*pages++
+		query := r.URL.Query()
+		if query.Get("job_definition_id") != "1" || query.Get("order_by") != "-id" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+
+		data := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			data = append(data, map[string]interface{}{
+				"id":                total - i,
+				"job_definition_id": 1,
+				"status":            dbt_cloud.RUN_STATUS_SUCCESS,
+				"created_at":        latest.Add(-time.Duration(i) * time.Hour).Format("2006-01-02 15:04:05.999999-07:00"),
+				"duration":          "00:01:05",
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": data,
+			"extra": map[string]interface{}{
+				"pagination": map[string]interface{}{"count": len(data), "total_count": total},
+			},
+		})
+	}))
+}
+
+func TestListRunsPaginates(t *testing.T) {
+	pages := 0
+	server := runsServer(t, 250, &pages)
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+	runs, err := c.ListRuns(dbt_cloud.RunFilter{JobDefinitionID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 250 || pages != 3 {
+		t.Fatalf("expected 250 runs over 3 pages, got %d runs over %d pages", len(runs), pages)
+	}
+	if *runs[0].ID != 250 || runs[0].DurationSeconds() != 65 {
+		t.Errorf("unexpected first run %d lasting %ds", *runs[0].ID, runs[0].DurationSeconds())
+	}
+}
+
+func TestListRunsTimeWindow(t *testing.T) {
+	pages := 0
+	server := runsServer(t, 250, &pages)
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+	runs, err := c.ListRuns(dbt_cloud.RunFilter{
+		JobDefinitionID: 1,
+		CreatedAfter:    time.Date(2021, 11, 5, 0, 0, 0, 0, time.UTC),
+		CreatedBefore:   time.Date(2021, 11, 5, 10, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []int{}
+	for _, run := range runs {
+		ids = append(ids, *run.ID)
+	}
+	// 10:00 is excluded, 00:00 included
+	if fmt.Sprint(ids) != "[247 246 245 244 243 242 241 240 239 238]" || pages != 1 {
+		t.Errorf("unexpected runs %v over %d pages", ids, pages)
+	}
+}
+
+func TestListRunsWithoutTotalCount(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		data := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < 150; i++ {
+			data = append(data, map[string]interface{}{"id": 150 - i, "job_definition_id": 1})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+	runs, err := c.ListRuns(dbt_cloud.RunFilter{JobDefinitionID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 150 || pages != 2 {
+		t.Errorf("expected 150 runs over 2 pages, got %d runs over %d pages", len(runs), pages)
+	}
+}
+
+func TestListRunsSkipsUnknownCreation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": [
+			{"id": 3, "created_at": "2021-11-05 11:00:00.000000+00:00"},
+			{"id": 2, "created_at": "yesterday"},
+			{"id": 1, "created_at": "2021-11-05 10:00:00.000000+00:00"}
+		], "extra": {"pagination": {"count": 3, "total_count": 3}}}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+	runs, err := c.ListRuns(dbt_cloud.RunFilter{CreatedAfter: time.Date(2021, 11, 5, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []int{}
+	for _, run := range runs {
+		ids = append(ids, *run.ID)
+	}
+	if fmt.Sprint(ids) != "[3 1]" {
+		t.Errorf("expected the run created at an unknown time to be skipped, got %v", ids)
+	}
+}
+
+func TestListActiveJobRuns(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status := r.URL.Query().Get("status__in"); status != "[1,2,3]" {
+			t.Errorf("unexpected status__in %s", status)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": 7, "job_definition_id": 1, "status": dbt_cloud.RUN_STATUS_RUNNING},
+			},
+			"extra": map[string]interface{}{
+				"pagination": map[string]interface{}{"count": 1, "total_count": 1},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+	runs, err := c.ListActiveJobRuns(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || *runs[0].ID != 7 {
+		t.Errorf("unexpected runs %+v", runs)
+	}
+}
+
+func TestCancelRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v2/accounts/1/runs/7/cancel/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"id": 7, "status": dbt_cloud.RUN_STATUS_CANCELLED},
+		})
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+	run, err := c.CancelRun(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Status != dbt_cloud.RUN_STATUS_CANCELLED {
+		t.Errorf("expected cancelled run, got status %d", run.Status)
+	}
+}
@@ -0,0 +1,200 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	SERVICE_TOKEN_STATE_ACTIVE   = 1
+	SERVICE_TOKEN_STATE_INACTIVE = 2
+)
+
+// Permission sets that can be granted to service tokens
+var ServiceTokenPermissionSets = []string{
+	"account_admin",
+	"security_admin",
+	"billing_admin",
+	"admin",
+	"database_admin",
+	"git_admin",
+	"team_admin",
+	"job_admin",
+	"job_runner",
+	"job_viewer",
+	"analyst",
+	"developer",
+	"stakeholder",
+	"readonly",
+	"project_creator",
+	"account_viewer",
+	"metadata_only",
+	"semantic_layer_only",
+	"webhooks_only",
+}
+
+type ServiceTokenPermission struct {
+	ID             *int   `json:"id,omitempty"`
+	AccountID      int    `json:"account_id"`
+	ServiceTokenID int    `json:"service_token_id"`
+	ProjectID      *int   `json:"project_id"`
+	AllProjects    bool   `json:"all_projects"`
+	State          int    `json:"state"`
+	PermissionSet  string `json:"permission_set"`
+}
+
+type ServiceToken struct {
+	ID        *int   `json:"id,omitempty"`
+	AccountID int    `json:"account_id"`
+	UID       string `json:"uid,omitempty"`
+	Name      string `json:"name"`
+	State     int    `json:"state"`
+	// Only returned when the token is created
+	TokenString string `json:"token_string,omitempty"`
+}
+
+type ServiceTokenResponse struct {
+	Data   ServiceToken   `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+type ServiceTokenPermissionListResponse struct {
+	Data   []ServiceTokenPermission `json:"data"`
+	Status ResponseStatus           `json:"status"`
+}
+
+func (c *Client) GetServiceToken(serviceTokenID int) (*ServiceToken, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/%d/", c.HostURL, c.AccountID, serviceTokenID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceTokenResponse := ServiceTokenResponse{}
+	err = json.Unmarshal(body, &serviceTokenResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceTokenResponse.Data, nil
+}
+
+// CreateServiceToken creates a service token, whose TokenString is only
+// available in the returned token
+func (c *Client) CreateServiceToken(name string, state int) (*ServiceToken, error) {
+	serviceToken := ServiceToken{
+		AccountID: c.AccountID,
+		Name:      name,
+		State:     state,
+	}
+
+	return c.saveServiceToken(serviceToken, fmt.Sprintf("%s/v3/accounts/%d/service-tokens/", c.HostURL, c.AccountID))
+}
+
+func (c *Client) UpdateServiceToken(serviceTokenID int, serviceToken ServiceToken) (*ServiceToken, error) {
+	serviceToken.TokenString = ""
+
+	return c.saveServiceToken(serviceToken, fmt.Sprintf("%s/v3/accounts/%d/service-tokens/%d/", c.HostURL, c.AccountID, serviceTokenID))
+}
+
+func (c *Client) saveServiceToken(serviceToken ServiceToken, url string) (*ServiceToken, error) {
+	serviceTokenData, err := json.Marshal(serviceToken)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(serviceTokenData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceTokenResponse := ServiceTokenResponse{}
+	err = json.Unmarshal(body, &serviceTokenResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceTokenResponse.Data, nil
+}
+
+func (c *Client) GetServiceTokenPermissions(serviceTokenID int) ([]ServiceTokenPermission, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/%d/permissions/", c.HostURL, c.AccountID, serviceTokenID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceTokenPermissionListResponse := ServiceTokenPermissionListResponse{}
+	err = json.Unmarshal(body, &serviceTokenPermissionListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return serviceTokenPermissionListResponse.Data, nil
+}
+
+// UpdateServiceTokenPermissions replaces all the permissions of a service
+// token
+func (c *Client) UpdateServiceTokenPermissions(serviceTokenID int, permissions []ServiceTokenPermission) ([]ServiceTokenPermission, error) {
+	for i := range permissions {
+		permissions[i].AccountID = c.AccountID
+		permissions[i].ServiceTokenID = serviceTokenID
+		permissions[i].State = STATE_ACTIVE
+	}
+	if permissions == nil {
+		permissions = []ServiceTokenPermission{}
+	}
+
+	permissionsData, err := json.Marshal(permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/%d/permissions/", c.HostURL, c.AccountID, serviceTokenID), strings.NewReader(string(permissionsData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceTokenPermissionListResponse := ServiceTokenPermissionListResponse{}
+	err = json.Unmarshal(body, &serviceTokenPermissionListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return serviceTokenPermissionListResponse.Data, nil
+}
+
+// DeleteServiceToken revokes a service token, it can't be used anymore
+func (c *Client) DeleteServiceToken(serviceTokenID int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/%d/", c.HostURL, c.AccountID, serviceTokenID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package dbt_cloud_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestServiceTokenLifecycle(t *testing.T) {
+	revoked := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v3/accounts/1/service-tokens/":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": 8, "account_id": 1, "uid": "abc", "name": "airflow", "state": 1, "token_string": "dbtc_secret"}}`))
+		case "GET /v3/accounts/1/service-tokens/8/":
+			if revoked {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"status": {"code": 404, "is_success": false}}`))
+				return
+			}
+			w.Write([]byte(`{"data": {"id": 8, "account_id": 1, "uid": "abc", "name": "airflow", "state": 1}}`))
+		case "DELETE /v3/accounts/1/service-tokens/8/":
+			revoked = true
+			w.Write([]byte(`{"data": {}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	serviceToken, err := c.CreateServiceToken("airflow", dbt_cloud.SERVICE_TOKEN_STATE_ACTIVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *serviceToken.ID != 8 || serviceToken.TokenString != "dbtc_secret" {
+		t.Errorf("unexpected service token %+v", serviceToken)
+	}
+
+	serviceToken, err = c.GetServiceToken(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serviceToken.TokenString != "" {
+		t.Errorf("expected no token string once created, got %s", serviceToken.TokenString)
+	}
+
+	if err := c.DeleteServiceToken(8); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetServiceToken(8); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error for a revoked token, got %v", err)
+	}
+}
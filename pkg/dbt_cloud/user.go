@@ -0,0 +1,203 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const usersPageSize = 100
+
+type UserGroup struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserPermission is the membership of a user in an account, with the license
+// and groups they have there
+type UserPermission struct {
+	ID          int         `json:"id"`
+	AccountID   int         `json:"account_id"`
+	UserID      int         `json:"user_id"`
+	LicenseType string      `json:"license_type"`
+	State       int         `json:"state"`
+	Groups      []UserGroup `json:"groups"`
+}
+
+type User struct {
+	ID          int              `json:"id"`
+	FirstName   string           `json:"first_name"`
+	LastName    string           `json:"last_name"`
+	Email       string           `json:"email"`
+	Permissions []UserPermission `json:"permissions"`
+}
+
+type UserResponse struct {
+	Data   User           `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+type UserListResponse struct {
+	Data   []User         `json:"data"`
+	Status ResponseStatus `json:"status"`
+	Extra  struct {
+		Pagination struct {
+			Count      int `json:"count"`
+			TotalCount int `json:"total_count"`
+		} `json:"pagination"`
+	} `json:"extra"`
+}
+
+type assignGroupsRequest struct {
+	UserID          int   `json:"user_id"`
+	DesiredGroupIDs []int `json:"desired_group_ids"`
+}
+
+type AssignGroupsResponse struct {
+	Data   []UserGroup    `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// AccountPermission returns the membership of the user in the account of
+// the client, nil if they aren't a member
+func (u *User) AccountPermission(accountID int) *UserPermission {
+	for i, permission := range u.Permissions {
+		if permission.AccountID == accountID && permission.State != STATE_DELETED {
+			return &u.Permissions[i]
+		}
+	}
+	return nil
+}
+
+// GroupIDs returns the IDs of the groups of the user in an account
+func (u *User) GroupIDs(accountID int) []int {
+	groupIDs := []int{}
+	if permission := u.AccountPermission(accountID); permission != nil {
+		for _, group := range permission.Groups {
+			groupIDs = append(groupIDs, group.ID)
+		}
+	}
+	return groupIDs
+}
+
+func (c *Client) GetUser(userID int) (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/users/%d/", c.HostURL, c.AccountID, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	userResponse := UserResponse{}
+	err = json.Unmarshal(body, &userResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if userResponse.Data.AccountPermission(c.AccountID) == nil {
+		return nil, fmt.Errorf("user %d %w in account %d", userID, ErrNotFound, c.AccountID)
+	}
+
+	return &userResponse.Data, nil
+}
+
+// ListUsers returns the users of the account, paginating through the API
+func (c *Client) ListUsers() ([]User, error) {
+	users := []User{}
+	for offset := 0; ; offset += usersPageSize {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/users/?limit=%d&offset=%d", c.HostURL, c.AccountID, usersPageSize, offset), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		userListResponse := UserListResponse{}
+		err = json.Unmarshal(body, &userListResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, userListResponse.Data...)
+
+		// Some listings don't report a total count, rely on full pages then
+		totalCount := userListResponse.Extra.Pagination.TotalCount
+		if len(userListResponse.Data) < usersPageSize || (totalCount > 0 && offset+usersPageSize >= totalCount) {
+			return users, nil
+		}
+	}
+}
+
+// CountLicensedUsers returns how many users of the account have each
+// license type
+func (c *Client) CountLicensedUsers() (map[string]int, error) {
+	users, err := c.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, user := range users {
+		if permission := user.AccountPermission(c.AccountID); permission != nil {
+			counts[permission.LicenseType]++
+		}
+	}
+	return counts, nil
+}
+
+// GetUserByEmail returns the user of the account with the given email,
+// compared case insensitively
+func (c *Client) GetUserByEmail(email string) (*User, error) {
+	users, err := c.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, user := range users {
+		if strings.EqualFold(user.Email, email) && user.AccountPermission(c.AccountID) != nil {
+			return &users[i], nil
+		}
+	}
+	return nil, fmt.Errorf("user with email %s %w in account %d", email, ErrNotFound, c.AccountID)
+}
+
+// AssignUserGroups sets the groups of a user, removing them from any other
+// group of the account
+func (c *Client) AssignUserGroups(userID int, groupIDs []int) ([]UserGroup, error) {
+	if groupIDs == nil {
+		groupIDs = []int{}
+	}
+
+	assignGroupsData, err := json.Marshal(assignGroupsRequest{
+		UserID:          userID,
+		DesiredGroupIDs: groupIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%d/assign-groups/", c.HostURL, c.AccountID), strings.NewReader(string(assignGroupsData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	assignGroupsResponse := AssignGroupsResponse{}
+	err = json.Unmarshal(body, &assignGroupsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignGroupsResponse.Data, nil
+}
@@ -0,0 +1,106 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	INVITE_STATE_PENDING  = 1
+	INVITE_STATE_ACCEPTED = 2
+	INVITE_STATE_REVOKED  = 3
+)
+
+type UserInvite struct {
+	ID          *int   `json:"id,omitempty"`
+	AccountID   int    `json:"account_id"`
+	Email       string `json:"email"`
+	LicenseType string `json:"license_type"`
+	GroupIDs    []int  `json:"groups"`
+	State       int    `json:"state,omitempty"`
+	// ID of the user who accepted the invite
+	UserID *int `json:"user_id,omitempty"`
+}
+
+type UserInviteResponse struct {
+	Data   UserInvite     `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// GetUserInvite returns an invite, revoked invites are not found
+func (c *Client) GetUserInvite(inviteID int) (*UserInvite, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%d/invites/%d/", c.HostURL, c.AccountID, inviteID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	userInviteResponse := UserInviteResponse{}
+	err = json.Unmarshal(body, &userInviteResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if userInviteResponse.Data.State == INVITE_STATE_REVOKED {
+		return nil, fmt.Errorf("invite %d %w", inviteID, ErrNotFound)
+	}
+
+	return &userInviteResponse.Data, nil
+}
+
+// CreateUserInvite sends an invite to join the account with a license and
+// groups
+func (c *Client) CreateUserInvite(email string, licenseType string, groupIDs []int) (*UserInvite, error) {
+	if groupIDs == nil {
+		groupIDs = []int{}
+	}
+
+	userInviteData, err := json.Marshal(UserInvite{
+		AccountID:   c.AccountID,
+		Email:       email,
+		LicenseType: licenseType,
+		GroupIDs:    groupIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v2/accounts/%d/invites/", c.HostURL, c.AccountID), strings.NewReader(string(userInviteData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	userInviteResponse := UserInviteResponse{}
+	err = json.Unmarshal(body, &userInviteResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userInviteResponse.Data, nil
+}
+
+// RevokeUserInvite revokes a pending invite, it can't be accepted anymore
+func (c *Client) RevokeUserInvite(inviteID int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v2/accounts/%d/invites/%d/", c.HostURL, c.AccountID, inviteID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestUserInviteLifecycle(t *testing.T) {
+	state := dbt_cloud.INVITE_STATE_PENDING
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/accounts/1/invites/":
+			body, _ := ioutil.ReadAll(r.Body)
+			invite := dbt_cloud.UserInvite{}
+			if err := json.Unmarshal(body, &invite); err != nil {
+				t.Fatal(err)
+			}
+			if invite.Email != "new.hire@example.com" || invite.LicenseType != "read_only" || len(invite.GroupIDs) != 0 {
+				t.Errorf("unexpected invite %s", body)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data": {"id": 4, "email": "new.hire@example.com", "license_type": "read_only", "groups": [], "state": 1}}`))
+		case "GET /v2/accounts/1/invites/4/":
+			w.Write([]byte(`{"data": {"id": 4, "email": "new.hire@example.com", "license_type": "read_only", "groups": [], "state": ` + strconv.Itoa(state) + `, "user_id": 12}}`))
+		case "DELETE /v2/accounts/1/invites/4/":
+			state = dbt_cloud.INVITE_STATE_REVOKED
+			w.Write([]byte(`{"data": {}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	invite, err := c.CreateUserInvite("new.hire@example.com", "read_only", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *invite.ID != 4 || invite.State != dbt_cloud.INVITE_STATE_PENDING {
+		t.Errorf("unexpected invite %+v", invite)
+	}
+
+	state = dbt_cloud.INVITE_STATE_ACCEPTED
+	invite, err = c.GetUserInvite(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invite.State != dbt_cloud.INVITE_STATE_ACCEPTED || *invite.UserID != 12 {
+		t.Errorf("expected the invite to be accepted by user 12, got %+v", invite)
+	}
+
+	if err := c.RevokeUserInvite(4); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetUserInvite(4); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error for a revoked invite, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestGetUserByEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/accounts/1/users/" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		// 101 users over 2 pages, the one looked up on the second page
+		offset := r.URL.Query().Get("offset")
+		users := []string{}
+		switch offset {
+		case "0":
+			for i := 0; i < 100; i++ {
+				users = append(users, fmt.Sprintf(`{"id": %d, "email": "user%d@example.com", "permissions": [{"account_id": 1, "state": 1}]}`, i, i))
+			}
+		case "100":
+			users = append(users, `{"id": 100, "email": "Analyst@Example.com", "permissions": [
+				{"account_id": 9, "state": 1, "license_type": "read_only"},
+				{"account_id": 1, "state": 1, "license_type": "developer", "groups": [{"id": 3, "name": "Everyone"}, {"id": 4, "name": "Analysts"}]}
+			]}`)
+		default:
+			t.Errorf("unexpected offset %s", offset)
+		}
+		w.Write([]byte(fmt.Sprintf(`{"data": [%s], "extra": {"pagination": {"count": %d, "total_count": 101}}}`, strings.Join(users, ","), len(users))))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	user, err := c.GetUserByEmail("analyst@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != 100 {
+		t.Errorf("expected user 100, got %d", user.ID)
+	}
+	if licenseType := user.AccountPermission(1).LicenseType; licenseType != "developer" {
+		t.Errorf("expected the license of account 1, got %s", licenseType)
+	}
+	if groupIds := user.GroupIDs(1); !reflect.DeepEqual(groupIds, []int{3, 4}) {
+		t.Errorf("expected groups 3 and 4, got %v", groupIds)
+	}
+
+	if _, err := c.GetUserByEmail("nobody@example.com"); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestListUsersWithoutTotalCount(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		users := []string{}
+		if r.URL.Query().Get("offset") == "0" {
+			for i := 0; i < 100; i++ {
+				users = append(users, fmt.Sprintf(`{"id": %d, "email": "user%d@example.com"}`, i, i))
+			}
+		}
+		w.Write([]byte(fmt.Sprintf(`{"data": [%s]}`, strings.Join(users, ","))))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	users, err := c.ListUsers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 100 || pages != 2 {
+		t.Errorf("expected 100 users over 2 pages, got %d users over %d pages", len(users), pages)
+	}
+}
+
+func TestGetUserNotInAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": {"id": 7, "email": "gone@example.com", "permissions": [{"account_id": 1, "state": 2}]}}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	if _, err := c.GetUser(7); !errors.Is(err, dbt_cloud.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestAssignUserGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v3/accounts/1/assign-groups/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		request := map[string]interface{}{}
+		if err := json.Unmarshal(body, &request); err != nil {
+			t.Fatal(err)
+		}
+		if request["user_id"] != float64(7) || !reflect.DeepEqual(request["desired_group_ids"], []interface{}{}) {
+			t.Errorf("unexpected request body %s", body)
+		}
+		w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	groups, err := c.AssignUserGroups(7, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 0 {
+		t.Errorf("expected no groups, got %v", groups)
+	}
+}
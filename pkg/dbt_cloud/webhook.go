@@ -0,0 +1,129 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	WEBHOOK_EVENT_JOB_RUN_STARTED   = "job.run.started"
+	WEBHOOK_EVENT_JOB_RUN_COMPLETED = "job.run.completed"
+	WEBHOOK_EVENT_JOB_RUN_ERRORED   = "job.run.errored"
+)
+
+var WebhookEventTypes = []string{
+	WEBHOOK_EVENT_JOB_RUN_STARTED,
+	WEBHOOK_EVENT_JOB_RUN_COMPLETED,
+	WEBHOOK_EVENT_JOB_RUN_ERRORED,
+}
+
+type Webhook struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	ClientURL   string   `json:"client_url"`
+	EventTypes  []string `json:"event_types"`
+	// Empty to get the events of all the jobs of the account
+	JobIDs []string `json:"job_ids"`
+	Active bool     `json:"active"`
+	// Secret the payloads are signed with, returned when the webhook is
+	// created or fetched on its own
+	HMACSecret string `json:"hmac_secret,omitempty"`
+}
+
+type WebhookResponse struct {
+	Data   Webhook        `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+func (c *Client) GetWebhook(webhookID string) (*Webhook, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/webhooks/subscription/%s", c.HostURL, c.AccountID, webhookID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookResponse := WebhookResponse{}
+	err = json.Unmarshal(body, &webhookResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookResponse.Data, nil
+}
+
+func (c *Client) CreateWebhook(name string, description string, clientURL string, eventTypes []string, jobIDs []int, active bool) (*Webhook, error) {
+	webhook := Webhook{
+		Name:        name,
+		Description: description,
+		ClientURL:   clientURL,
+		EventTypes:  eventTypes,
+		JobIDs:      WebhookJobIDs(jobIDs),
+		Active:      active,
+	}
+
+	return c.saveWebhook(webhook, "POST", fmt.Sprintf("%s/v3/accounts/%d/webhooks/subscriptions", c.HostURL, c.AccountID))
+}
+
+func (c *Client) UpdateWebhook(webhookID string, webhook Webhook) (*Webhook, error) {
+	return c.saveWebhook(webhook, "PUT", fmt.Sprintf("%s/v3/accounts/%d/webhooks/subscription/%s", c.HostURL, c.AccountID, webhookID))
+}
+
+func (c *Client) saveWebhook(webhook Webhook, method string, url string) (*Webhook, error) {
+	if webhook.JobIDs == nil {
+		webhook.JobIDs = []string{}
+	}
+
+	webhookData, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, url, strings.NewReader(string(webhookData)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookResponse := WebhookResponse{}
+	err = json.Unmarshal(body, &webhookResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookResponse.Data, nil
+}
+
+func (c *Client) DeleteWebhook(webhookID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/webhooks/subscription/%s", c.HostURL, c.AccountID, webhookID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WebhookJobIDs converts job IDs to the strings the webhooks API takes
+func WebhookJobIDs(jobIDs []int) []string {
+	webhookJobIDs := []string{}
+	for _, jobID := range jobIDs {
+		webhookJobIDs = append(webhookJobIDs, strconv.Itoa(jobID))
+	}
+	return webhookJobIDs
+}
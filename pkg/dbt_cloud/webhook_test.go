@@ -0,0 +1,40 @@
+package dbt_cloud_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v3/accounts/1/webhooks/subscriptions" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		webhook := dbt_cloud.Webhook{}
+		if err := json.Unmarshal(body, &webhook); err != nil {
+			t.Fatal(err)
+		}
+		if len(webhook.JobIDs) != 2 || webhook.JobIDs[0] != "10" || webhook.JobIDs[1] != "11" {
+			t.Errorf("expected job IDs as strings, got %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data": {"id": "wsu_abc", "name": "incidents", "client_url": "https://example.com/dbt", "event_types": ["job.run.errored"], "job_ids": ["10", "11"], "active": true, "hmac_secret": "secret"}}`))
+	}))
+	defer server.Close()
+
+	c := dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+
+	webhook, err := c.CreateWebhook("incidents", "", "https://example.com/dbt", []string{dbt_cloud.WEBHOOK_EVENT_JOB_RUN_ERRORED}, []int{10, 11}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook.ID != "wsu_abc" || webhook.HMACSecret != "secret" {
+		t.Errorf("unexpected webhook %+v", webhook)
+	}
+}
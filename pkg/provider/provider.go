@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/data_sources"
 	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
@@ -26,19 +27,58 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("DBT_CLOUD_ACCOUNT_ID", nil),
 				Description: "Account identifier for your DBT Cloud implementation",
 			},
+			"execute_steps_validation": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      dbt_cloud.CommandValidationWarning,
+				ValidateFunc: validation.StringInSlice([]string{dbt_cloud.CommandValidationWarning, dbt_cloud.CommandValidationError}, false),
+				Description:  "How problems found in job execute_steps are reported, either warning or error to fail the plan. Problems that don't depend on the dbt version are always shown as warnings, error then fails the plan without repeating them. With warning, problems that depend on the dbt version of the job are only reported when the job is applied",
+			},
+			"caller_ip": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DBT_CLOUD_CALLER_IP", ""),
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsIPAddress),
+				Description:  "Public IP Terraform calls dbt Cloud from, checked against the IP restrictions rules of the account before they're changed. Changing enforced rules requires it, detect_caller_ip, or allow_lockout on the rule",
+			},
+			"detect_caller_ip": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DBT_CLOUD_DETECT_CALLER_IP", false),
+				Description: "Detect the public IP Terraform calls dbt Cloud from with " + dbt_cloud.CallerIPURL + " when caller_ip isn't set",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"dbt_cloud_job":                  data_sources.DatasourceJob(),
 			"dbt_cloud_project":              data_sources.DatasourceProject(),
 			"dbt_cloud_environment":          data_sources.DatasourceEnvironment(),
 			"dbt_cloud_snowflake_credential": data_sources.DatasourceSnowflakeCredential(),
+			"dbt_cloud_run":                  data_sources.DatasourceRun(),
+			"dbt_cloud_runs":                 data_sources.DatasourceRuns(),
+			"dbt_cloud_run_artifacts":        data_sources.DatasourceRunArtifacts(),
+			"dbt_cloud_dbt_versions":         data_sources.DatasourceDbtVersions(),
+			"dbt_cloud_user":                 data_sources.DatasourceUser(),
+			"dbt_cloud_connection":           data_sources.DatasourceConnection(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"dbt_cloud_job":                  resources.ResourceJob(),
-			"dbt_cloud_project":              resources.ResourceProject(),
-			"dbt_cloud_environment":          resources.ResourceEnvironment(),
-			"dbt_cloud_snowflake_credential": resources.ResourceSnowflakeCredential(),
-			"dbt_cloud_credential":           resources.ResourceCredential(),
+			"dbt_cloud_job":                               resources.ResourceJob(),
+			"dbt_cloud_project":                           resources.ResourceProject(),
+			"dbt_cloud_environment":                       resources.ResourceEnvironment(),
+			"dbt_cloud_snowflake_credential":              resources.ResourceSnowflakeCredential(),
+			"dbt_cloud_credential":                        resources.ResourceCredential(),
+			"dbt_cloud_environment_variable":              resources.ResourceEnvironmentVariable(),
+			"dbt_cloud_environment_variable_job_override": resources.ResourceEnvironmentVariableJobOverride(),
+			"dbt_cloud_job_run":                           resources.ResourceJobRun(),
+			"dbt_cloud_extended_attributes":               resources.ResourceExtendedAttributes(),
+			"dbt_cloud_group":                             resources.ResourceGroup(),
+			"dbt_cloud_ip_restrictions_rule":              resources.ResourceIPRestrictionsRule(),
+			"dbt_cloud_oauth_configuration":               resources.ResourceOAuthConfiguration(),
+			"dbt_cloud_user_groups":                       resources.ResourceUserGroups(),
+			"dbt_cloud_service_token":                     resources.ResourceServiceToken(),
+			"dbt_cloud_license_map":                       resources.ResourceLicenseMap(),
+			"dbt_cloud_notification":                      resources.ResourceNotification(),
+			"dbt_cloud_user_invite":                       resources.ResourceUserInvite(),
+			"dbt_cloud_webhook":                           resources.ResourceWebhook(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -48,6 +88,9 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	token := d.Get("token").(string)
 	account_id := d.Get("account_id").(int)
+	executeStepsValidation := d.Get("execute_steps_validation").(string)
+	callerIP := d.Get("caller_ip").(string)
+	detectCallerIP := d.Get("detect_caller_ip").(bool)
 
 	var diags diag.Diagnostics
 
@@ -63,6 +106,10 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 			return nil, diags
 		}
 
+		c.ExecuteStepsValidation = executeStepsValidation
+		c.CallerIP = callerIP
+		c.DetectCallerIP = detectCallerIP
+
 		return c, diags
 	}
 
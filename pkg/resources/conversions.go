@@ -13,3 +13,11 @@ func ResourceDataInterfaceMap(d *schema.ResourceData, key string) map[string]int
 
 	return map[string]interface{}{}
 }
+
+func interfaceSliceToStrings(values []interface{}) []string {
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, value.(string))
+	}
+	return strs
+}
@@ -3,8 +3,6 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -110,7 +108,7 @@ func ResourceCredential() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importProjectScopedResource("credential", resolveCredentialId),
 		},
 	}
 }
@@ -158,12 +156,7 @@ func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, m inter
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	credentialId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
+	projectId, credentialId, err := parseProjectScopedId(d.Id(), "credential")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -220,12 +213,7 @@ func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, m inter
 func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*dbt_cloud.Client)
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	credentialId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
+	projectId, credentialId, err := parseProjectScopedId(d.Id(), "credential")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -275,12 +263,7 @@ func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, m int
 
 	var diags diag.Diagnostics
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	credentialId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
+	projectId, credentialId, err := parseProjectScopedId(d.Id(), "credential")
 	if err != nil {
 		return diag.FromErr(err)
 	}
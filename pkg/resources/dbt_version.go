@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceDbtVersionCustomizeDiff checks dbt_version against the versions
+// available in the account whenever it's set or changed.
+func resourceDbtVersionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	if d.Id() != "" && !d.HasChange("dbt_version") {
+		return nil
+	}
+	if !d.NewValueKnown("dbt_version") {
+		return nil
+	}
+	version := d.Get("dbt_version").(string)
+	if version == "" || version == dbt_cloud.DBT_VERSION_VERSIONLESS {
+		return nil
+	}
+
+	versions, err := c.GetDbtVersions()
+	if err != nil {
+		// Not being able to list versions shouldn't block plans, dbt Cloud
+		// still validates the version when it's applied
+		log.Printf("[WARN] Can't validate dbt_version %s, listing dbt versions failed: %s", version, err)
+		return nil
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+
+	_, err = c.GetDbtVersion(version)
+	return err
+}
+
+// dbtVersionDiagnostics warns when a pinned dbt version is deprecated.
+func dbtVersionDiagnostics(c *dbt_cloud.Client, version string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if version == "" || version == dbt_cloud.DBT_VERSION_VERSIONLESS {
+		return diags
+	}
+
+	dbtVersion, err := c.GetDbtVersion(version)
+	if err != nil || dbtVersion == nil || !dbtVersion.IsDeprecated {
+		return diags
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Deprecated dbt version",
+		Detail:   fmt.Sprintf("dbt version %s is deprecated and will stop being available in dbt Cloud. Upgrade to a supported version, or use %q to always run the latest dbt.", version, dbt_cloud.DBT_VERSION_VERSIONLESS),
+	})
+}
@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const ENVIRONMENT_STATE_ACTIVE = 1
@@ -39,6 +40,12 @@ func ResourceEnvironment() *schema.Resource {
 				Default:     nil,
 				Description: "Credential ID to create the environment with",
 			},
+			"connection_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Connection ID of the warehouse the environment runs against, defaults to the connection of the project. The credential must be for the same adapter as the connection",
+			},
 			"name": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -47,7 +54,7 @@ func ResourceEnvironment() *schema.Resource {
 			"dbt_version": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Version number of dbt to use in this environment",
+				Description: "Version number of dbt to use in this environment, or versionless to always run the latest dbt",
 			},
 			"type": &schema.Schema{
 				Type:        schema.TypeString,
@@ -65,6 +72,17 @@ func ResourceEnvironment() *schema.Resource {
 					return
 				},
 			},
+			"deployment_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The type of deployment environment, production or staging, leave empty for a general deployment environment. A project can only have one production environment, which is checked at plan time when the project already exists and again before the environment is created or updated",
+				ValidateFunc: validation.StringInSlice(deploymentTypes, false),
+			},
+			"extended_attributes_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the extended attributes overriding the profile fields of the environment",
+			},
 			"use_custom_branch": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -72,10 +90,26 @@ func ResourceEnvironment() *schema.Resource {
 				Description: "Whether to use a custom git branch in this environment",
 			},
 			"custom_branch": &schema.Schema{
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				Description:  "Which custom branch to use in this environment, required when use_custom_branch is true and only allowed then unless allow_unused_custom_branch is set",
+				ValidateFunc: validateCustomBranch,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return !d.Get("use_custom_branch").(bool) && d.Get("allow_unused_custom_branch").(bool)
+				},
+			},
+			"allow_unused_custom_branch": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether custom_branch may be set while use_custom_branch is false, for configurations written before the two were checked together. The branch is then ignored and not sent to dbt Cloud",
+			},
+			"verify_custom_branch": &schema.Schema{
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     "",
-				Description: "Which custom branch to use in this environment",
+				Default:     false,
+				Description: "Whether to check custom_branch exists in the repository of the project when planning, using git ls-remote with the git credentials of the machine running Terraform. Only https, ssh and git remotes are checked",
 			},
 			"environment_id": &schema.Schema{
 				Type:        schema.TypeInt,
@@ -84,12 +118,188 @@ func ResourceEnvironment() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customdiff.All(
+			resourceEnvironmentDeploymentTypeCustomizeDiff,
+			resourceDbtVersionCustomizeDiff,
+			resourceEnvironmentConnectionCustomizeDiff,
+			resourceEnvironmentCustomBranchCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importEnvironment,
 		},
 	}
 }
 
+var deploymentTypes = []string{
+	dbt_cloud.DEPLOYMENT_TYPE_PRODUCTION,
+	dbt_cloud.DEPLOYMENT_TYPE_STAGING,
+}
+
+// resourceEnvironmentDeploymentTypeCustomizeDiff only allows deployment
+// types on deployment environments, and checks the project doesn't already
+// have another production environment. The check is skipped when project_id
+// isn't known yet, and can't see other environments of the same plan, so
+// Create and Update check again before calling the API.
+func resourceEnvironmentDeploymentTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	deploymentType := d.Get("deployment_type").(string)
+	if deploymentType == "" {
+		return nil
+	}
+	if type_ := d.Get("type").(string); type_ != "deployment" {
+		return fmt.Errorf("deployment_type can only be set on deployment environments, got type %q", type_)
+	}
+
+	if deploymentType != dbt_cloud.DEPLOYMENT_TYPE_PRODUCTION {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("deployment_type") && !d.HasChange("project_id") {
+		return nil
+	}
+	// New projects have no environments yet
+	if !d.NewValueKnown("project_id") {
+		return nil
+	}
+
+	environmentId := 0
+	if d.Id() != "" {
+		environmentId = d.Get("environment_id").(int)
+	}
+	return checkSingleProductionEnvironment(c, d.Get("project_id").(int), environmentId)
+}
+
+// checkSingleProductionEnvironment fails when the project has a production
+// environment other than environmentId, which is 0 for new environments.
+func checkSingleProductionEnvironment(c *dbt_cloud.Client, projectId int, environmentId int) error {
+	production, err := c.GetProductionEnvironment(projectId)
+	if err != nil {
+		return err
+	}
+	if production == nil {
+		return nil
+	}
+	if environmentId != 0 && production.ID != nil && environmentId == *production.ID {
+		return nil
+	}
+	return fmt.Errorf("project %d already has a production environment, %s (ID %d)", projectId, production.Name, *production.ID)
+}
+
+// resourceEnvironmentConnectionCustomizeDiff checks the credential of the
+// environment is for the same adapter as its connection. connection_id is
+// computed, so new environments leaving it out to use the connection of the
+// project can't be told apart from unknown values here, Create checks those.
+func resourceEnvironmentConnectionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	if d.Id() != "" && !d.HasChange("connection_id") && !d.HasChange("credential_id") {
+		return nil
+	}
+	if !d.NewValueKnown("project_id") || !d.NewValueKnown("connection_id") || !d.NewValueKnown("credential_id") {
+		return nil
+	}
+
+	return checkEnvironmentConnection(c, d.Get("project_id").(int), d.Get("connection_id").(int), d.Get("credential_id").(int))
+}
+
+// checkEnvironmentConnection checks the credential is for the same adapter as
+// the connection, or the connection of the project when connectionId is 0.
+func checkEnvironmentConnection(c *dbt_cloud.Client, projectId int, connectionId int, credentialId int) error {
+	if credentialId == 0 {
+		return nil
+	}
+
+	if connectionId == 0 {
+		project, err := c.GetProject(strconv.Itoa(projectId))
+		if err != nil {
+			return err
+		}
+		if project.ConnectionID == nil {
+			return nil
+		}
+		connectionId = *project.ConnectionID
+	}
+
+	connection, err := c.GetConnection(connectionId, projectId)
+	if err != nil {
+		return err
+	}
+	credential, err := c.GetCredential(projectId, credentialId)
+	if err != nil {
+		return err
+	}
+
+	return dbt_cloud.CheckEnvironmentConnection(connection, credential)
+}
+
+func validateCustomBranch(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	if v == "" {
+		return warnings, errors
+	}
+	if err := dbt_cloud.ValidateBranchName(v); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+	return warnings, errors
+}
+
+// resourceEnvironmentCustomBranchCustomizeDiff requires custom_branch to be
+// set exactly when use_custom_branch is true, unless allow_unused_custom_branch
+// is set, and checks the branch exists in the repository of the project when
+// verify_custom_branch is set.
+func resourceEnvironmentCustomBranchCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	if !d.NewValueKnown("use_custom_branch") || !d.NewValueKnown("custom_branch") {
+		return nil
+	}
+
+	useCustomBranch := d.Get("use_custom_branch").(bool)
+	customBranch := d.Get("custom_branch").(string)
+	if useCustomBranch && customBranch == "" {
+		return fmt.Errorf("custom_branch must be set when use_custom_branch is true")
+	}
+	if !useCustomBranch && customBranch != "" && !d.Get("allow_unused_custom_branch").(bool) {
+		return fmt.Errorf("custom_branch %q is only used when use_custom_branch is true, set use_custom_branch or remove custom_branch", customBranch)
+	}
+
+	if !useCustomBranch || !d.Get("verify_custom_branch").(bool) {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("use_custom_branch") && !d.HasChange("custom_branch") && !d.HasChange("verify_custom_branch") && !d.HasChange("project_id") {
+		return nil
+	}
+	if !d.NewValueKnown("project_id") {
+		return nil
+	}
+
+	projectId := d.Get("project_id").(int)
+	project, err := c.GetProject(strconv.Itoa(projectId))
+	if err != nil {
+		return err
+	}
+	if project.RepositoryID == nil {
+		return fmt.Errorf("can't verify custom_branch %q, project %d has no repository", customBranch, projectId)
+	}
+	repository, err := c.GetRepository(strconv.Itoa(*project.RepositoryID))
+	if err != nil {
+		return err
+	}
+
+	exists, err := dbt_cloud.BranchExists(ctx, repository.RemoteURL, customBranch)
+	if err != nil {
+		return fmt.Errorf("can't verify custom_branch %q: %s", customBranch, err)
+	}
+	if !exists {
+		return fmt.Errorf("custom_branch %q doesn't exist in %s", customBranch, repository.RemoteURL)
+	}
+	return nil
+}
+
 func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*dbt_cloud.Client)
 
@@ -102,15 +312,32 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m in
 	dbtVersion := d.Get("dbt_version").(string)
 	type_ := d.Get("type").(string)
 	useCustomBranch := d.Get("use_custom_branch").(bool)
-	customBranch := d.Get("custom_branch").(string)
+	customBranch := ""
+	if useCustomBranch {
+		customBranch = d.Get("custom_branch").(string)
+	}
+	deploymentType := d.Get("deployment_type").(string)
+	extendedAttributesId := d.Get("extended_attributes_id").(int)
+	connectionId := d.Get("connection_id").(int)
+
+	if deploymentType == dbt_cloud.DEPLOYMENT_TYPE_PRODUCTION {
+		if err := checkSingleProductionEnvironment(c, projectId, 0); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := checkEnvironmentConnection(c, projectId, connectionId, credentialId); err != nil {
+		return diag.FromErr(err)
+	}
 
-	environment, err := c.CreateEnvironment(isActive, projectId, name, dbtVersion, type_, useCustomBranch, customBranch, credentialId)
+	environment, err := c.CreateEnvironment(isActive, projectId, name, dbtVersion, type_, useCustomBranch, customBranch, credentialId, deploymentType, extendedAttributesId, connectionId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d%s%d", environment.Project_Id, dbt_cloud.ID_DELIMITER, *environment.ID))
 
+	diags = append(diags, dbtVersionDiagnostics(c, dbtVersion)...)
+
 	resourceEnvironmentRead(ctx, d, m)
 
 	return diags
@@ -121,12 +348,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	var diags diag.Diagnostics
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	environmentId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
+	projectId, environmentId, err := parseProjectScopedId(d.Id(), "environment")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -148,12 +370,21 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 	if err := d.Set("credential_id", environment.Credential_Id); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("connection_id", environment.Connection_Id); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("dbt_version", environment.Dbt_Version); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("type", environment.Type); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("deployment_type", environment.Deployment_Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("extended_attributes_id", environment.Extended_Attributes_Id); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("use_custom_branch", environment.Use_Custom_Branch); err != nil {
 		return diag.FromErr(err)
 	}
@@ -170,19 +401,16 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*dbt_cloud.Client)
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
+	projectId, environmentId, err := parseProjectScopedId(d.Id(), "environment")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	environmentId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	var diags diag.Diagnostics
 
 	// TODO: add more changes here
 
-	if d.HasChange("name") || d.HasChange("credential_id") {
+	if d.HasChange("name") || d.HasChange("credential_id") || d.HasChange("dbt_version") || d.HasChange("deployment_type") || d.HasChange("extended_attributes_id") || d.HasChange("connection_id") || d.HasChange("use_custom_branch") || d.HasChange("custom_branch") {
 		environment, err := c.GetEnvironment(projectId, environmentId)
 		if err != nil {
 			return diag.FromErr(err)
@@ -198,13 +426,57 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m in
 			environment.Credential_Id = &credentialId
 		}
 
+		if d.HasChange("dbt_version") {
+			dbtVersion := d.Get("dbt_version").(string)
+			environment.Dbt_Version = dbtVersion
+			diags = append(diags, dbtVersionDiagnostics(c, dbtVersion)...)
+		}
+
+		if d.HasChange("deployment_type") {
+			deploymentType := d.Get("deployment_type").(string)
+			if deploymentType == dbt_cloud.DEPLOYMENT_TYPE_PRODUCTION {
+				if err := checkSingleProductionEnvironment(c, projectId, environmentId); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			environment.Deployment_Type = nil
+			if deploymentType != "" {
+				environment.Deployment_Type = &deploymentType
+			}
+		}
+
+		if d.HasChange("extended_attributes_id") {
+			extendedAttributesId := d.Get("extended_attributes_id").(int)
+			environment.Extended_Attributes_Id = nil
+			if extendedAttributesId != 0 {
+				environment.Extended_Attributes_Id = &extendedAttributesId
+			}
+		}
+
+		if d.HasChange("connection_id") {
+			connectionId := d.Get("connection_id").(int)
+			environment.Connection_Id = nil
+			if connectionId != 0 {
+				environment.Connection_Id = &connectionId
+			}
+		}
+
+		if d.HasChange("use_custom_branch") || d.HasChange("custom_branch") {
+			customBranch := d.Get("custom_branch").(string)
+			environment.Use_Custom_Branch = d.Get("use_custom_branch").(bool)
+			environment.Custom_Branch = nil
+			if environment.Use_Custom_Branch && customBranch != "" {
+				environment.Custom_Branch = &customBranch
+			}
+		}
+
 		_, err = c.UpdateEnvironment(projectId, environmentId, *environment)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	return resourceEnvironmentRead(ctx, d, m)
+	return append(diags, resourceEnvironmentRead(ctx, d, m)...)
 }
 
 func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -212,12 +484,7 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m in
 
 	var diags diag.Diagnostics
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	environmentId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
+	projectId, environmentId, err := parseProjectScopedId(d.Id(), "environment")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -29,6 +29,7 @@ func TestAccDbtCloudEnvironmentResource(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDbtCloudEnvironmentExists("dbt_cloud_environment.test_env"),
 					resource.TestCheckResourceAttr("dbt_cloud_environment.test_env", "name", environmentName),
+					resource.TestCheckResourceAttr("dbt_cloud_environment.test_env", "deployment_type", "production"),
 				),
 			},
 			// RENAME
@@ -55,6 +56,14 @@ func TestAccDbtCloudEnvironmentResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{},
 			},
+			// IMPORT BY NAME
+			{
+				ResourceName:            "dbt_cloud_environment.test_env",
+				ImportState:             true,
+				ImportStateId:           projectName + "/" + environmentName2,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
 		},
 	})
 }
@@ -68,6 +77,7 @@ resource "dbt_cloud_project" "test_project" {
 resource "dbt_cloud_environment" "test_env" {
   name        = "%s"
   type = "deployment"
+  deployment_type = "production"
   dbt_version = "0.21.0"
   project_id = dbt_cloud_project.test_project.id
 }
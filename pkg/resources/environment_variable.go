@@ -0,0 +1,229 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceEnvironmentVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentVariableCreate,
+		ReadContext:   resourceEnvironmentVariableRead,
+		UpdateContext: resourceEnvironmentVariableUpdate,
+		DeleteContext: resourceEnvironmentVariableDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID to create the environment variable in",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the environment variable, starting with DBT_, or DBT_ENV_SECRET_ for values dbt Cloud should mask",
+				ValidateFunc: validateEnvironmentVariableName,
+			},
+			"environment_values": &schema.Schema{
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Sensitive:    true,
+				ValidateFunc: validateEnvironmentValues,
+				Description:  "Values of the environment variable, keyed by project for the project default and by environment name for environment specific values. Values of DBT_ENV_SECRET_ variables are masked by dbt Cloud, so they are never read back and changes made outside of Terraform aren't detected",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func validateEnvironmentValues(i interface{}, k string) (warnings []string, errors []error) {
+	values, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be map", k))
+		return warnings, errors
+	}
+	if _, ok := values[dbt_cloud.EnvironmentVariableProjectLevel]; !ok {
+		errors = append(errors, fmt.Errorf("%s must contain a %q key with the project default value", k, dbt_cloud.EnvironmentVariableProjectLevel))
+	}
+	return warnings, errors
+}
+
+func validateEnvironmentVariableName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	if err := dbt_cloud.ValidateEnvironmentVariableName(v); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+	return warnings, errors
+}
+
+// environmentVariableCredentialDiagnostics warns about values that look like
+// credentials stored under a name dbt Cloud doesn't mask. The name isn't
+// available when validating values, so this runs at apply time.
+func environmentVariableCredentialDiagnostics(name string, values map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	levels := make([]string, 0, len(values))
+	for level := range values {
+		levels = append(levels, level)
+	}
+	sort.Strings(levels)
+
+	for _, level := range levels {
+		reason := dbt_cloud.LooksLikeCredential(name, values[level])
+		if reason == "" {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Possible credential in a non-secret environment variable",
+			Detail: fmt.Sprintf("The %s value of %s looks like a credential (%s). dbt Cloud only masks variables named %s..., so this value is visible in the UI and in logs. Rename the variable to use that prefix if it is a secret.",
+				level, name, reason, dbt_cloud.EnvironmentVariableSecretPrefix),
+		})
+	}
+
+	return diags
+}
+
+// Environment variables are identified by project_id:name
+func parseEnvironmentVariableId(id string) (int, string, error) {
+	parts := strings.SplitN(id, dbt_cloud.ID_DELIMITER, 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", fmt.Errorf("invalid ID %q, expected project_id%sname", id, dbt_cloud.ID_DELIMITER)
+	}
+
+	projectId, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid project ID in %q: %s", id, err)
+	}
+
+	return projectId, parts[1], nil
+}
+
+func environmentValues(d *schema.ResourceData) map[string]string {
+	values := map[string]string{}
+	for level, value := range d.Get("environment_values").(map[string]interface{}) {
+		values[level] = value.(string)
+	}
+	return values
+}
+
+func resourceEnvironmentVariableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(int)
+	name := d.Get("name").(string)
+
+	_, err := c.CreateEnvironmentVariable(projectId, name, environmentValues(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d%s%s", projectId, dbt_cloud.ID_DELIMITER, name))
+
+	diags = append(diags, environmentVariableCredentialDiagnostics(name, environmentValues(d))...)
+
+	resourceEnvironmentVariableRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceEnvironmentVariableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId, name, err := parseEnvironmentVariableId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	environmentVariable, err := c.GetEnvironmentVariable(projectId, name)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		// Removed outside of Terraform, plan to recreate it
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := environmentVariable.EnvironmentValues
+	if dbt_cloud.IsSecretEnvironmentVariable(name) {
+		// Secret values come back masked, keep the ones Terraform wrote for
+		// the levels that still exist, the masked value for new ones makes
+		// them show up as a change
+		stateValues := d.Get("environment_values").(map[string]interface{})
+		for level := range values {
+			if value, ok := stateValues[level]; ok {
+				values[level] = value.(string)
+			}
+		}
+	}
+
+	if err := d.Set("project_id", projectId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("environment_values", values); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceEnvironmentVariableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	if d.HasChange("environment_values") {
+		name := d.Get("name").(string)
+		values := environmentValues(d)
+
+		_, err := c.UpdateEnvironmentVariable(d.Get("project_id").(int), name, values)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags = append(diags, environmentVariableCredentialDiagnostics(name, values)...)
+	}
+
+	return append(diags, resourceEnvironmentVariableRead(ctx, d, m)...)
+}
+
+func resourceEnvironmentVariableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteEnvironmentVariable(d.Get("project_id").(int), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,138 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudEnvironmentVariableResource(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudEnvironmentVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudEnvironmentVariableResourceBasicConfig(projectName, environmentName, "first_schema"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableExists("dbt_cloud_environment_variable.test_variable"),
+					resource.TestCheckResourceAttr("dbt_cloud_environment_variable.test_variable", "environment_values.%", "2"),
+					resource.TestCheckResourceAttr("dbt_cloud_environment_variable.test_variable", "environment_values.project", "default_schema"),
+					resource.TestCheckResourceAttr("dbt_cloud_environment_variable.test_variable", fmt.Sprintf("environment_values.%s", environmentName), "first_schema"),
+					testAccCheckDbtCloudEnvironmentVariableExists("dbt_cloud_environment_variable.test_secret"),
+					resource.TestCheckResourceAttr("dbt_cloud_environment_variable.test_secret", "environment_values.project", "first_schema"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudEnvironmentVariableResourceBasicConfig(projectName, environmentName, "second_schema"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableExists("dbt_cloud_environment_variable.test_variable"),
+					resource.TestCheckResourceAttr("dbt_cloud_environment_variable.test_variable", fmt.Sprintf("environment_values.%s", environmentName), "second_schema"),
+					resource.TestCheckResourceAttr("dbt_cloud_environment_variable.test_secret", "environment_values.project", "second_schema"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_environment_variable.test_variable",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudEnvironmentVariableResourceBasicConfig(projectName, environmentName, value string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "0.21.0"
+  project_id = dbt_cloud_project.test_project.id
+}
+
+resource "dbt_cloud_environment_variable" "test_variable" {
+  project_id = dbt_cloud_project.test_project.id
+  name = "DBT_TARGET_SCHEMA"
+  environment_values = {
+    "project": "default_schema",
+    (dbt_cloud_environment.test_env.name): "%s"
+  }
+}
+
+resource "dbt_cloud_environment_variable" "test_secret" {
+  project_id = dbt_cloud_project.test_project.id
+  name = "DBT_ENV_SECRET_SCHEMA"
+  environment_values = {
+    "project": "%s"
+  }
+}
+`, projectName, environmentName, value, value)
+}
+
+func testAccCheckDbtCloudEnvironmentVariableExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		parts := strings.SplitN(rs.Primary.ID, dbt_cloud.ID_DELIMITER, 2)
+		projectId, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return fmt.Errorf("Can't get projectId")
+		}
+
+		_, err = apiClient.GetEnvironmentVariable(projectId, parts[1])
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudEnvironmentVariableDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_environment_variable" {
+			continue
+		}
+		parts := strings.SplitN(rs.Primary.ID, dbt_cloud.ID_DELIMITER, 2)
+		projectId, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return fmt.Errorf("Can't get projectId")
+		}
+
+		_, err = apiClient.GetEnvironmentVariable(projectId, parts[1])
+		if err == nil {
+			return fmt.Errorf("Environment variable still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceEnvironmentVariableJobOverride() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentVariableJobOverrideCreate,
+		ReadContext:   resourceEnvironmentVariableJobOverrideRead,
+		UpdateContext: resourceEnvironmentVariableJobOverrideUpdate,
+		DeleteContext: resourceEnvironmentVariableJobOverrideDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID the job is in",
+			},
+			"job_definition_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the job to override the environment variable for",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the environment variable to override",
+				ValidateFunc: validateEnvironmentVariableName,
+			},
+			"raw_value": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Value of the environment variable for this job",
+			},
+			"environment_variable_job_override_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The system ID of the job override",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// Job overrides are identified by project_id:job_id:name
+func parseEnvironmentVariableJobOverrideId(id string) (int, int, string, error) {
+	parts := strings.SplitN(id, dbt_cloud.ID_DELIMITER, 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return 0, 0, "", fmt.Errorf("invalid ID %q, expected project_id%sjob_id%sname", id, dbt_cloud.ID_DELIMITER, dbt_cloud.ID_DELIMITER)
+	}
+
+	projectId, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("invalid project ID in %q: %s", id, err)
+	}
+	jobId, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("invalid job ID in %q: %s", id, err)
+	}
+
+	return projectId, jobId, parts[2], nil
+}
+
+func resourceEnvironmentVariableJobOverrideCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(int)
+	jobId := d.Get("job_definition_id").(int)
+	name := d.Get("name").(string)
+
+	_, err := c.CreateEnvironmentVariableJobOverride(&dbt_cloud.EnvironmentVariableJobOverride{
+		ProjectID:       projectId,
+		JobDefinitionID: jobId,
+		Name:            name,
+		RawValue:        d.Get("raw_value").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d%s%d%s%s", projectId, dbt_cloud.ID_DELIMITER, jobId, dbt_cloud.ID_DELIMITER, name))
+
+	diags = append(diags, environmentVariableCredentialDiagnostics(name, map[string]string{
+		dbt_cloud.EnvironmentVariableTypeJob: d.Get("raw_value").(string),
+	})...)
+
+	resourceEnvironmentVariableJobOverrideRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceEnvironmentVariableJobOverrideRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId, jobId, name, err := parseEnvironmentVariableJobOverrideId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	override, err := c.GetEnvironmentVariableJobOverride(projectId, jobId, name)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		// Removed outside of Terraform, plan to recreate it
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("project_id", projectId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("job_definition_id", jobId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", override.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	rawValue := override.RawValue
+	if dbt_cloud.IsSecretEnvironmentVariable(name) && d.Get("raw_value").(string) != "" {
+		// Secret values come back masked, keep the one Terraform wrote
+		rawValue = d.Get("raw_value").(string)
+	}
+	if err := d.Set("raw_value", rawValue); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("environment_variable_job_override_id", override.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceEnvironmentVariableJobOverrideUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	if d.HasChange("raw_value") {
+		overrideId := d.Get("environment_variable_job_override_id").(int)
+		name := d.Get("name").(string)
+		rawValue := d.Get("raw_value").(string)
+
+		_, err := c.UpdateEnvironmentVariableJobOverride(&dbt_cloud.EnvironmentVariableJobOverride{
+			ID:              &overrideId,
+			ProjectID:       d.Get("project_id").(int),
+			JobDefinitionID: d.Get("job_definition_id").(int),
+			Name:            name,
+			RawValue:        rawValue,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags = append(diags, environmentVariableCredentialDiagnostics(name, map[string]string{
+			dbt_cloud.EnvironmentVariableTypeJob: rawValue,
+		})...)
+	}
+
+	return append(diags, resourceEnvironmentVariableJobOverrideRead(ctx, d, m)...)
+}
+
+func resourceEnvironmentVariableJobOverrideDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteEnvironmentVariableJobOverride(d.Get("project_id").(int), d.Get("environment_variable_job_override_id").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,156 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudEnvironmentVariableJobOverrideResource(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudEnvironmentVariableJobOverrideDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudEnvironmentVariableJobOverrideResourceBasicConfig(projectName, environmentName, jobName, "first_schema"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableJobOverrideExists("dbt_cloud_environment_variable_job_override.test_override"),
+					resource.TestCheckResourceAttr("dbt_cloud_environment_variable_job_override.test_override", "raw_value", "first_schema"),
+					resource.TestCheckResourceAttrSet("dbt_cloud_environment_variable_job_override.test_override", "environment_variable_job_override_id"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudEnvironmentVariableJobOverrideResourceBasicConfig(projectName, environmentName, jobName, "second_schema"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableJobOverrideExists("dbt_cloud_environment_variable_job_override.test_override"),
+					resource.TestCheckResourceAttr("dbt_cloud_environment_variable_job_override.test_override", "raw_value", "second_schema"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_environment_variable_job_override.test_override",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudEnvironmentVariableJobOverrideResourceBasicConfig(projectName, environmentName, jobName, value string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "0.21.0"
+  project_id = dbt_cloud_project.test_project.id
+}
+
+resource "dbt_cloud_job" "test_job" {
+  name        = "%s"
+  project_id = dbt_cloud_project.test_project.id
+  environment_id = dbt_cloud_environment.test_env.environment_id
+  execute_steps = [
+    "dbt run"
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": false,
+    "custom_branch_only": false,
+  }
+}
+
+resource "dbt_cloud_environment_variable" "test_variable" {
+  project_id = dbt_cloud_project.test_project.id
+  name = "DBT_TARGET_SCHEMA"
+  environment_values = {
+    "project": "default_schema"
+  }
+}
+
+resource "dbt_cloud_environment_variable_job_override" "test_override" {
+  project_id = dbt_cloud_project.test_project.id
+  job_definition_id = dbt_cloud_job.test_job.id
+  name = dbt_cloud_environment_variable.test_variable.name
+  raw_value = "%s"
+}
+`, projectName, environmentName, jobName, value)
+}
+
+func testAccCheckDbtCloudEnvironmentVariableJobOverrideExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		parts := strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)
+		projectId, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return fmt.Errorf("Can't get projectId")
+		}
+		jobId, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return fmt.Errorf("Can't get jobId")
+		}
+
+		_, err = apiClient.GetEnvironmentVariableJobOverride(projectId, jobId, parts[2])
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudEnvironmentVariableJobOverrideDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_environment_variable_job_override" {
+			continue
+		}
+		parts := strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)
+		projectId, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return fmt.Errorf("Can't get projectId")
+		}
+		jobId, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return fmt.Errorf("Can't get jobId")
+		}
+
+		_, err = apiClient.GetEnvironmentVariableJobOverride(projectId, jobId, parts[2])
+		if err == nil {
+			return fmt.Errorf("Environment variable job override still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
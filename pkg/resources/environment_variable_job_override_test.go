@@ -0,0 +1,49 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/resources"
+)
+
+func TestEnvironmentVariableJobOverrideRead(t *testing.T) {
+	listing := `{"data": {"DBT_TARGET_SCHEMA": {"project": {"id": 10, "value": "analytics"}, "job": {"id": 20, "value": "changed_schema"}}}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/accounts/1/projects/2/environment-variables/job/" || r.URL.Query().Get("job_definition_id") != "3" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(listing))
+	}))
+	defer server.Close()
+
+	c := &dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+	r := resources.ResourceEnvironmentVariableJobOverride()
+
+	// Drift: the value was changed outside of Terraform
+	d := r.TestResourceData()
+	d.SetId("2:3:DBT_TARGET_SCHEMA")
+	if err := d.Set("raw_value", "first_schema"); err != nil {
+		t.Fatal(err)
+	}
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	if d.Id() != "2:3:DBT_TARGET_SCHEMA" || d.Get("raw_value").(string) != "changed_schema" || d.Get("environment_variable_job_override_id").(int) != 20 {
+		t.Errorf("expected the override to be read back with its new value, got %s %v", d.Id(), d.Get("raw_value"))
+	}
+
+	// Not found: only the project value is left
+	listing = `{"data": {"DBT_TARGET_SCHEMA": {"project": {"id": 10, "value": "analytics"}, "job": {"id": null, "value": null}}}}`
+	d = r.TestResourceData()
+	d.SetId("2:3:DBT_TARGET_SCHEMA")
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the removed override to be dropped from state, got ID %q", d.Id())
+	}
+}
@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceExtendedAttributes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceExtendedAttributesCreate,
+		ReadContext:   resourceExtendedAttributesRead,
+		UpdateContext: resourceExtendedAttributesUpdate,
+		DeleteContext: resourceExtendedAttributesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID to create the extended attributes in",
+			},
+			"extended_attributes": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "YAML or JSON document overriding fields of the profile of the environments using these extended attributes, e.g. query_tag. Must be a flat mapping of field names to values",
+				ValidateFunc: validateExtendedAttributes,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return dbt_cloud.ExtendedAttributesEqual(old, new)
+				},
+			},
+			"extended_attributes_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Extended attributes ID, to set on environments",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func validateExtendedAttributes(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	if _, err := dbt_cloud.ParseExtendedAttributes(v); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+	return warnings, errors
+}
+
+func resourceExtendedAttributesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(int)
+
+	extendedAttributes, err := c.CreateExtendedAttributes(projectId, d.Get("extended_attributes").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d%s%d", projectId, dbt_cloud.ID_DELIMITER, *extendedAttributes.ID))
+
+	resourceExtendedAttributesRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceExtendedAttributesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId, extendedAttributesId, err := parseProjectScopedId(d.Id(), "extended_attributes")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	extendedAttributes, err := c.GetExtendedAttributes(projectId, extendedAttributesId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		// Removed outside of Terraform, plan to recreate it
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep the document as written in the configuration while it means the
+	// same as what dbt Cloud has
+	document := string(extendedAttributes.ExtendedAttributes)
+	if current := d.Get("extended_attributes").(string); dbt_cloud.ExtendedAttributesEqual(current, document) {
+		document = current
+	}
+
+	if err := d.Set("project_id", projectId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("extended_attributes", document); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("extended_attributes_id", extendedAttributesId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceExtendedAttributesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	if d.HasChange("extended_attributes") {
+		projectId, extendedAttributesId, err := parseProjectScopedId(d.Id(), "extended_attributes")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = c.UpdateExtendedAttributes(projectId, extendedAttributesId, d.Get("extended_attributes").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceExtendedAttributesRead(ctx, d, m)
+}
+
+func resourceExtendedAttributesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	projectId, extendedAttributesId, err := parseProjectScopedId(d.Id(), "extended_attributes")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteExtendedAttributes(projectId, extendedAttributesId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,134 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudExtendedAttributesResource(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudExtendedAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudExtendedAttributesResourceBasicConfig(projectName, environmentName, `jsonencode({ query_tag = "terraform", threads = 4 })`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudExtendedAttributesExists("dbt_cloud_extended_attributes.test_attributes"),
+					resource.TestCheckResourceAttrSet("dbt_cloud_extended_attributes.test_attributes", "extended_attributes_id"),
+					resource.TestCheckResourceAttrPair("dbt_cloud_environment.test_env", "extended_attributes_id", "dbt_cloud_extended_attributes.test_attributes", "extended_attributes_id"),
+				),
+			},
+			// SAME ATTRIBUTES AS YAML, NO CHANGES
+			{
+				Config:   testAccDbtCloudExtendedAttributesResourceBasicConfig(projectName, environmentName, "<<-EOT\n    threads: 4\n    query_tag: terraform\n  EOT"),
+				PlanOnly: true,
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudExtendedAttributesResourceBasicConfig(projectName, environmentName, `jsonencode({ query_tag = "terraform_modified" })`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudExtendedAttributesExists("dbt_cloud_extended_attributes.test_attributes"),
+					resource.TestCheckResourceAttr("dbt_cloud_extended_attributes.test_attributes", "extended_attributes", `{"query_tag":"terraform_modified"}`),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_extended_attributes.test_attributes",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudExtendedAttributesResourceBasicConfig(projectName, environmentName, extendedAttributes string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_extended_attributes" "test_attributes" {
+  project_id = dbt_cloud_project.test_project.id
+  extended_attributes = %s
+}
+
+resource "dbt_cloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "0.21.0"
+  project_id = dbt_cloud_project.test_project.id
+  extended_attributes_id = dbt_cloud_extended_attributes.test_attributes.extended_attributes_id
+}
+`, projectName, extendedAttributes, environmentName)
+}
+
+func testAccCheckDbtCloudExtendedAttributesExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		projectId, err := strconv.Atoi(strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)[0])
+		if err != nil {
+			return fmt.Errorf("Can't get projectId")
+		}
+		extendedAttributesId, err := strconv.Atoi(strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)[1])
+		if err != nil {
+			return fmt.Errorf("Can't get extendedAttributesId")
+		}
+
+		_, err = apiClient.GetExtendedAttributes(projectId, extendedAttributesId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudExtendedAttributesDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_extended_attributes" {
+			continue
+		}
+		projectId, err := strconv.Atoi(strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)[0])
+		if err != nil {
+			return fmt.Errorf("Can't get projectId")
+		}
+		extendedAttributesId, err := strconv.Atoi(strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)[1])
+		if err != nil {
+			return fmt.Errorf("Can't get extendedAttributesId")
+		}
+
+		_, err = apiClient.GetExtendedAttributes(projectId, extendedAttributesId)
+		if err == nil {
+			return fmt.Errorf("Extended attributes still exist")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
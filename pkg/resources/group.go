@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupCreate,
+		ReadContext:   resourceGroupRead,
+		UpdateContext: resourceGroupUpdate,
+		DeleteContext: resourceGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Group name",
+			},
+			"assign_by_default": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether new users are added to the group",
+			},
+			"sso_mapping_groups": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "SSO groups whose members are added to the group",
+			},
+			"group_permissions": projectPermissionsSchema("Permissions of the group, each granting a permission set on all projects or on one project", dbt_cloud.PermissionSets),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandGroupPermissions(permissions *schema.Set) ([]dbt_cloud.GroupPermission, error) {
+	projectPermissions, err := expandProjectPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPermissions := []dbt_cloud.GroupPermission{}
+	for _, permission := range projectPermissions {
+		groupPermissions = append(groupPermissions, dbt_cloud.GroupPermission{
+			PermissionSet: permission.PermissionSet,
+			AllProjects:   permission.AllProjects,
+			ProjectID:     permission.ProjectID,
+		})
+	}
+	return groupPermissions, nil
+}
+
+func flattenGroupPermissions(permissions []dbt_cloud.GroupPermission) []interface{} {
+	groupPermissions := []interface{}{}
+	for _, permission := range permissions {
+		if permission.State == dbt_cloud.STATE_DELETED {
+			continue
+		}
+		groupPermissions = append(groupPermissions, flattenProjectPermission(permission.PermissionSet, permission.AllProjects, permission.ProjectID))
+	}
+	return groupPermissions
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	permissions, err := expandGroupPermissions(d.Get("group_permissions").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	assignByDefault := d.Get("assign_by_default").(bool)
+	ssoMappingGroups := interfaceSliceToStrings(d.Get("sso_mapping_groups").(*schema.Set).List())
+
+	group, err := c.CreateGroup(name, assignByDefault, ssoMappingGroups)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*group.ID))
+
+	if len(permissions) > 0 {
+		_, err = c.UpdateGroupPermissions(*group.ID, permissions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resourceGroupRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	groupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := c.GetGroup(groupId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", group.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("assign_by_default", group.AssignByDefault); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sso_mapping_groups", group.SSOMappingGroups); err != nil {
+		return diag.FromErr(err)
+	}
+	// Permissions come back in no particular order, the set makes the
+	// order irrelevant to the diff
+	if err := d.Set("group_permissions", flattenGroupPermissions(group.Permissions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	groupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("assign_by_default") || d.HasChange("sso_mapping_groups") {
+		group, err := c.GetGroup(groupId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		group.Name = d.Get("name").(string)
+		group.AssignByDefault = d.Get("assign_by_default").(bool)
+		group.SSOMappingGroups = interfaceSliceToStrings(d.Get("sso_mapping_groups").(*schema.Set).List())
+
+		_, err = c.UpdateGroup(groupId, *group)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("group_permissions") {
+		permissions, err := expandGroupPermissions(d.Get("group_permissions").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = c.UpdateGroupPermissions(groupId, permissions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGroupRead(ctx, d, m)
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	groupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteGroup(groupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,177 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudGroupResource(t *testing.T) {
+
+	groupName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	groupName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudGroupResourceBasicConfig(groupName, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudGroupExists("dbt_cloud_group.test_group"),
+					resource.TestCheckResourceAttr("dbt_cloud_group.test_group", "name", groupName),
+					resource.TestCheckResourceAttr("dbt_cloud_group.test_group", "group_permissions.#", "1"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudGroupResourceFullConfig(groupName2, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudGroupExists("dbt_cloud_group.test_group"),
+					resource.TestCheckResourceAttr("dbt_cloud_group.test_group", "name", groupName2),
+					resource.TestCheckResourceAttr("dbt_cloud_group.test_group", "assign_by_default", "true"),
+					resource.TestCheckResourceAttr("dbt_cloud_group.test_group", "sso_mapping_groups.#", "2"),
+					resource.TestCheckResourceAttr("dbt_cloud_group.test_group", "group_permissions.#", "3"),
+				),
+			},
+			// PERMISSIONS IN ANOTHER ORDER, NO CHANGES
+			{
+				Config:   testAccDbtCloudGroupResourceReorderedConfig(groupName2, projectName),
+				PlanOnly: true,
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_group.test_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudGroupResourceBasicConfig(groupName, projectName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_group" "test_group" {
+  name = "%s"
+  group_permissions {
+    permission_set = "developer"
+    project_id     = dbt_cloud_project.test_project.id
+  }
+}
+`, projectName, groupName)
+}
+
+func testAccDbtCloudGroupResourceFullConfig(groupName, projectName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_group" "test_group" {
+  name               = "%s"
+  assign_by_default  = true
+  sso_mapping_groups = ["analytics", "data-engineering"]
+  group_permissions {
+    permission_set = "developer"
+    project_id     = dbt_cloud_project.test_project.id
+  }
+  group_permissions {
+    permission_set = "job_admin"
+    project_id     = dbt_cloud_project.test_project.id
+  }
+  group_permissions {
+    permission_set = "job_viewer"
+    all_projects   = true
+  }
+}
+`, projectName, groupName)
+}
+
+func testAccDbtCloudGroupResourceReorderedConfig(groupName, projectName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_group" "test_group" {
+  name               = "%s"
+  assign_by_default  = true
+  sso_mapping_groups = ["data-engineering", "analytics"]
+  group_permissions {
+    permission_set = "job_viewer"
+    all_projects   = true
+  }
+  group_permissions {
+    permission_set = "job_admin"
+    project_id     = dbt_cloud_project.test_project.id
+  }
+  group_permissions {
+    permission_set = "developer"
+    project_id     = dbt_cloud_project.test_project.id
+  }
+}
+`, projectName, groupName)
+}
+
+func testAccCheckDbtCloudGroupExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		groupId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get groupId")
+		}
+
+		_, err = apiClient.GetGroup(groupId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudGroupDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_group" {
+			continue
+		}
+		groupId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get groupId")
+		}
+
+		_, err = apiClient.GetGroup(groupId)
+		if err == nil {
+			return fmt.Errorf("Group still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IMPORT_NAME_DELIMITER separates the project name from the name of the
+// resource in import IDs, e.g. my_project/Production
+const IMPORT_NAME_DELIMITER = "/"
+
+// parseProjectScopedId parses the IDs of resources living in a project,
+// written project_id:<kind>_id
+func parseProjectScopedId(id string, kind string) (int, int, error) {
+	parts := strings.Split(id, dbt_cloud.ID_DELIMITER)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid ID %q, expected project_id%s%s_id", id, dbt_cloud.ID_DELIMITER, kind)
+	}
+
+	projectId, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid project ID in %q: %s", id, err)
+	}
+	resourceId, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid %s ID in %q: %s", strings.ReplaceAll(kind, "_", " "), id, err)
+	}
+
+	return projectId, resourceId, nil
+}
+
+// splitImportName splits project_name/name import IDs. Names after the first
+// delimiter can contain it, project names can't.
+func splitImportName(id string) (string, string, bool) {
+	parts := strings.SplitN(id, IMPORT_NAME_DELIMITER, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// importProjectScopedResource imports resources with project_id:<kind>_id
+// IDs, also accepting project_name/<name> where resolve looks up the ID of the
+// resource from its name within the project.
+func importProjectScopedResource(kind string, resolve func(c *dbt_cloud.Client, projectId int, name string) (int, error)) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		c := m.(*dbt_cloud.Client)
+
+		if strings.Contains(d.Id(), dbt_cloud.ID_DELIMITER) {
+			if _, _, err := parseProjectScopedId(d.Id(), kind); err != nil {
+				return nil, err
+			}
+			return []*schema.ResourceData{d}, nil
+		}
+
+		projectName, name, ok := splitImportName(d.Id())
+		if !ok {
+			return nil, fmt.Errorf("invalid import ID %q, expected project_id%s%s_id or project_name%s%s_name", d.Id(), dbt_cloud.ID_DELIMITER, kind, IMPORT_NAME_DELIMITER, kind)
+		}
+
+		project, err := c.GetProjectByName(projectName)
+		if err != nil {
+			return nil, err
+		}
+		resourceId, err := resolve(c, *project.ID, name)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(fmt.Sprintf("%d%s%d", *project.ID, dbt_cloud.ID_DELIMITER, resourceId))
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func importEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// The custom branch checks only live in the configuration, start from
+	// their defaults so imported environments don't plan an update
+	if err := d.Set("verify_custom_branch", false); err != nil {
+		return nil, err
+	}
+	if err := d.Set("allow_unused_custom_branch", false); err != nil {
+		return nil, err
+	}
+
+	return importProjectScopedResource("environment", resolveEnvironmentId)(ctx, d, m)
+}
+
+func resolveEnvironmentId(c *dbt_cloud.Client, projectId int, name string) (int, error) {
+	environment, err := c.GetEnvironmentByName(projectId, name)
+	if err != nil {
+		return 0, err
+	}
+	return *environment.ID, nil
+}
+
+// resolveCredentialId resolves credentials, which have no names, from their
+// ID within the named project
+func resolveCredentialId(c *dbt_cloud.Client, projectId int, name string) (int, error) {
+	credentialId, err := strconv.Atoi(name)
+	if err != nil {
+		return 0, fmt.Errorf("credentials have no names, import them with project_name%scredential_id: invalid credential ID %q", IMPORT_NAME_DELIMITER, name)
+	}
+	return credentialId, nil
+}
+
+func importLicenseMap(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// fail_on_full_seats only lives in the configuration, start from its
+	// default so imported license maps don't plan an update
+	if err := d.Set("fail_on_full_seats", false); err != nil {
+		return nil, err
+	}
+	return schema.ImportStatePassthroughContext(ctx, d, m)
+}
+
+// importJob imports jobs by job ID or project_name/job_name
+func importJob(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*dbt_cloud.Client)
+
+	// on_destroy only lives in the configuration, start from its default
+	// so imported jobs don't plan an update
+	if err := d.Set("on_destroy", jobActiveRunsIgnore); err != nil {
+		return nil, err
+	}
+
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	projectName, jobName, ok := splitImportName(d.Id())
+	if !ok {
+		return nil, fmt.Errorf("invalid import ID %q, expected job_id or project_name%sjob_name", d.Id(), IMPORT_NAME_DELIMITER)
+	}
+
+	project, err := c.GetProjectByName(projectName)
+	if err != nil {
+		return nil, err
+	}
+	job, err := c.GetJobByName(*project.ID, jobName)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(strconv.Itoa(*job.ID))
+	return []*schema.ResourceData{d}, nil
+}
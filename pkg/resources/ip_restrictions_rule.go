@@ -0,0 +1,331 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIPRestrictionsRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIPRestrictionsRuleCreate,
+		ReadContext:   resourceIPRestrictionsRuleRead,
+		UpdateContext: resourceIPRestrictionsRuleUpdate,
+		DeleteContext: resourceIPRestrictionsRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Rule name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Rule description",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Rule type, allow to only let the CIDRs call dbt Cloud or deny to block them",
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+			},
+			"cidrs": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        ipRestrictionsCidrSchema(),
+				Description: "IP ranges the rule applies to",
+			},
+			"exceptions": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        ipRestrictionsCidrSchema(),
+				Description: "IP ranges of the CIDRs the rule doesn't apply to",
+			},
+			"rule_set_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the IP restrictions of the account are enforced. This is a setting of the whole rule set: enabling it on any rule enforces every rule of the account, so give all rules the same value",
+			},
+			"allow_lockout": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Apply the rule even if the IP restrictions would keep Terraform from calling dbt Cloud",
+			},
+		},
+
+		CustomizeDiff: resourceIPRestrictionsRuleLockoutCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func ipRestrictionsCidrSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cidr": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "IP range in CIDR notation, IPv4 or IPv6",
+				ValidateFunc: validateCidr,
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "IP range description",
+			},
+		},
+	}
+}
+
+// validateCidr checks that the value is a network in CIDR notation, without
+// host bits that dbt Cloud would drop
+func validateCidr(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	ip, network, err := net.ParseCIDR(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a CIDR like 203.0.113.0/24 or 2001:db8::/32, got %q", k, v))
+		return warnings, errors
+	}
+	if !ip.Equal(network.IP) {
+		errors = append(errors, fmt.Errorf("expected %s to be a network address, got %q, did you mean %q?", k, v, network.String()))
+	}
+
+	return warnings, errors
+}
+
+func expandIPRestrictionsCidrs(cidrs *schema.Set) []dbt_cloud.IPRestrictionsCIDR {
+	ipRestrictionsCidrs := []dbt_cloud.IPRestrictionsCIDR{}
+	for _, cidr := range cidrs.List() {
+		cidr := cidr.(map[string]interface{})
+		ipRestrictionsCidrs = append(ipRestrictionsCidrs, dbt_cloud.IPRestrictionsCIDR{
+			CIDR:        cidr["cidr"].(string),
+			Description: cidr["description"].(string),
+		})
+	}
+	return ipRestrictionsCidrs
+}
+
+func flattenIPRestrictionsCidrs(cidrs []dbt_cloud.IPRestrictionsCIDR) []interface{} {
+	ipRestrictionsCidrs := []interface{}{}
+	for _, cidr := range cidrs {
+		ipRestrictionsCidrs = append(ipRestrictionsCidrs, map[string]interface{}{
+			"cidr":        cidr.CIDR,
+			"description": cidr.Description,
+		})
+	}
+	return ipRestrictionsCidrs
+}
+
+func ipRestrictionsRuleType(ruleType int) string {
+	for name, value := range dbt_cloud.IPRestrictionsTypes {
+		if value == ruleType {
+			return name
+		}
+	}
+	return ""
+}
+
+// checkIPRestrictionsLockout returns an error if the rules of the account
+// would keep Terraform from calling dbt Cloud once the rule with ruleId is
+// replaced by the planned one, or removed if planned is nil
+func checkIPRestrictionsLockout(c *dbt_cloud.Client, ruleId int, planned *dbt_cloud.IPRestrictionsRule) error {
+	current, err := c.ListIPRestrictionsRules()
+	if err != nil {
+		return err
+	}
+	rules := []dbt_cloud.IPRestrictionsRule{}
+	for _, rule := range current {
+		if rule.ID == nil || *rule.ID != ruleId {
+			rules = append(rules, rule)
+		}
+	}
+	if planned != nil {
+		rules = append(rules, *planned)
+	}
+	if !dbt_cloud.IPRestrictionsEnforced(rules) {
+		return nil
+	}
+
+	callerIp, err := c.GetCallerIP()
+	if err != nil {
+		return fmt.Errorf("%s, set caller_ip or detect_caller_ip in the provider, or allow_lockout to skip the check", err)
+	}
+	if dbt_cloud.IPRestrictionsExclude(rules, callerIp) {
+		return fmt.Errorf("the IP restrictions would keep Terraform, calling from %s, from reaching dbt Cloud. Set allow_lockout to apply them anyway", callerIp)
+	}
+	return nil
+}
+
+// resourceIPRestrictionsRuleLockoutCustomizeDiff refuses rules that would
+// lock Terraform out of dbt Cloud, unless allow_lockout is set
+func resourceIPRestrictionsRuleLockoutCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	if d.Get("allow_lockout").(bool) {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("type") && !d.HasChange("cidrs") && !d.HasChange("exceptions") && !d.HasChange("rule_set_enabled") {
+		return nil
+	}
+	for _, key := range []string{"type", "cidrs", "exceptions", "rule_set_enabled"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	ruleId := 0
+	if d.Id() != "" {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return err
+		}
+		ruleId = id
+	}
+
+	return checkIPRestrictionsLockout(c, ruleId, &dbt_cloud.IPRestrictionsRule{
+		Type:           dbt_cloud.IPRestrictionsTypes[d.Get("type").(string)],
+		CIDRs:          expandIPRestrictionsCidrs(d.Get("cidrs").(*schema.Set)),
+		Exceptions:     expandIPRestrictionsCidrs(d.Get("exceptions").(*schema.Set)),
+		RuleSetEnabled: d.Get("rule_set_enabled").(bool),
+	})
+}
+
+func expandIPRestrictionsRule(d *schema.ResourceData, rule *dbt_cloud.IPRestrictionsRule) {
+	rule.Name = d.Get("name").(string)
+	rule.Description = d.Get("description").(string)
+	rule.Type = dbt_cloud.IPRestrictionsTypes[d.Get("type").(string)]
+	rule.CIDRs = expandIPRestrictionsCidrs(d.Get("cidrs").(*schema.Set))
+	rule.Exceptions = expandIPRestrictionsCidrs(d.Get("exceptions").(*schema.Set))
+	rule.RuleSetEnabled = d.Get("rule_set_enabled").(bool)
+}
+
+func resourceIPRestrictionsRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	rule := dbt_cloud.IPRestrictionsRule{}
+	expandIPRestrictionsRule(d, &rule)
+
+	createdRule, err := c.CreateIPRestrictionsRule(rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*createdRule.ID))
+
+	resourceIPRestrictionsRuleRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceIPRestrictionsRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	ruleId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, err := c.GetIPRestrictionsRule(ruleId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", rule.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", rule.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", ipRestrictionsRuleType(rule.Type)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cidrs", flattenIPRestrictionsCidrs(rule.CIDRs)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("exceptions", flattenIPRestrictionsCidrs(rule.Exceptions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rule_set_enabled", rule.RuleSetEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceIPRestrictionsRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	ruleId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("type") || d.HasChange("cidrs") || d.HasChange("exceptions") || d.HasChange("rule_set_enabled") {
+		rule, err := c.GetIPRestrictionsRule(ruleId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		expandIPRestrictionsRule(d, rule)
+
+		_, err = c.UpdateIPRestrictionsRule(ruleId, *rule)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIPRestrictionsRuleRead(ctx, d, m)
+}
+
+func resourceIPRestrictionsRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	ruleId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Removing an allow rule can exclude the IPs only it allowed, or enforce
+	// the rule set if it was the only rule disabling it
+	if !d.Get("allow_lockout").(bool) && d.Get("type").(string) == "allow" {
+		if err := checkIPRestrictionsLockout(c, ruleId, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = c.DeleteIPRestrictionsRule(ruleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,202 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Enforcing rules requires the IP the tests call dbt Cloud from
+func testAccIPRestrictionsPreCheck(t *testing.T) {
+	if os.Getenv("DBT_CLOUD_CALLER_IP") == "" && os.Getenv("DBT_CLOUD_DETECT_CALLER_IP") == "" {
+		t.Skip("DBT_CLOUD_CALLER_IP or DBT_CLOUD_DETECT_CALLER_IP must be set")
+	}
+}
+
+func TestAccDbtCloudIPRestrictionsRuleResource(t *testing.T) {
+
+	testAccIPRestrictionsPreCheck(t)
+
+	ruleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	ruleName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudIPRestrictionsRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudIPRestrictionsRuleResourceBasicConfig(ruleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudIPRestrictionsRuleExists("dbt_cloud_ip_restrictions_rule.test_rule"),
+					resource.TestCheckResourceAttr("dbt_cloud_ip_restrictions_rule.test_rule", "name", ruleName),
+					resource.TestCheckResourceAttr("dbt_cloud_ip_restrictions_rule.test_rule", "type", "deny"),
+					resource.TestCheckResourceAttr("dbt_cloud_ip_restrictions_rule.test_rule", "cidrs.#", "1"),
+					resource.TestCheckResourceAttr("dbt_cloud_ip_restrictions_rule.test_rule", "rule_set_enabled", "false"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudIPRestrictionsRuleResourceFullConfig(ruleName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudIPRestrictionsRuleExists("dbt_cloud_ip_restrictions_rule.test_rule"),
+					resource.TestCheckResourceAttr("dbt_cloud_ip_restrictions_rule.test_rule", "name", ruleName2),
+					resource.TestCheckResourceAttr("dbt_cloud_ip_restrictions_rule.test_rule", "cidrs.#", "2"),
+					resource.TestCheckResourceAttr("dbt_cloud_ip_restrictions_rule.test_rule", "exceptions.#", "1"),
+					resource.TestCheckResourceAttr("dbt_cloud_ip_restrictions_rule.test_rule", "rule_set_enabled", "true"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_ip_restrictions_rule.test_rule",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_lockout"},
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudIPRestrictionsRuleResourceValidation(t *testing.T) {
+
+	testAccIPRestrictionsPreCheck(t)
+
+	ruleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "dbt_cloud_ip_restrictions_rule" "test_rule" {
+  name = "%s"
+  type = "deny"
+  cidrs {
+    cidr = "192.0.2.300/24"
+  }
+}
+`, ruleName),
+				ExpectError: regexp.MustCompile("to be a CIDR like"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "dbt_cloud_ip_restrictions_rule" "test_rule" {
+  name = "%s"
+  type = "deny"
+  cidrs {
+    cidr = "192.0.2.1/24"
+  }
+}
+`, ruleName),
+				ExpectError: regexp.MustCompile("did you mean \"192.0.2.0/24\""),
+			},
+			// Only allowing a documentation range locks everyone out
+			{
+				Config: fmt.Sprintf(`
+resource "dbt_cloud_ip_restrictions_rule" "test_rule" {
+  name             = "%s"
+  type             = "allow"
+  rule_set_enabled = true
+  cidrs {
+    cidr = "192.0.2.0/24"
+  }
+}
+`, ruleName),
+				ExpectError: regexp.MustCompile("would keep Terraform"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudIPRestrictionsRuleResourceBasicConfig(ruleName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_ip_restrictions_rule" "test_rule" {
+  name = "%s"
+  type = "deny"
+  cidrs {
+    cidr        = "192.0.2.0/24"
+    description = "Documentation range"
+  }
+}
+`, ruleName)
+}
+
+func testAccDbtCloudIPRestrictionsRuleResourceFullConfig(ruleName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_ip_restrictions_rule" "test_rule" {
+  name             = "%s"
+  description      = "Documentation ranges"
+  type             = "deny"
+  rule_set_enabled = true
+  cidrs {
+    cidr        = "192.0.2.0/24"
+    description = "Documentation range"
+  }
+  cidrs {
+    cidr = "2001:db8::/32"
+  }
+  exceptions {
+    cidr        = "192.0.2.128/25"
+    description = "Half of it"
+  }
+}
+`, ruleName)
+}
+
+func testAccCheckDbtCloudIPRestrictionsRuleExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		ruleId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get ruleId")
+		}
+
+		_, err = apiClient.GetIPRestrictionsRule(ruleId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudIPRestrictionsRuleDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_ip_restrictions_rule" {
+			continue
+		}
+		ruleId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get ruleId")
+		}
+
+		_, err = apiClient.GetIPRestrictionsRule(ruleId)
+		if err == nil {
+			return fmt.Errorf("IP restrictions rule still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"time"
 
 	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -40,14 +42,15 @@ var jobSchema = map[string]*schema.Schema{
 		MinItems: 1,
 		Required: true,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:         schema.TypeString,
+			ValidateFunc: validateExecuteStep,
 		},
 		Description: "List of commands to execute for the job",
 	},
 	"dbt_version": &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Version number of DBT to use in this job",
+		Description: "Version number of DBT to use in this job, or versionless to always run the latest dbt, defaults to the version of the environment",
 	},
 	"is_active": &schema.Schema{
 		Type:        schema.TypeBool,
@@ -109,7 +112,8 @@ var jobSchema = map[string]*schema.Schema{
 		MinItems: 1,
 		Optional: true,
 		Elem: &schema.Schema{
-			Type: schema.TypeInt,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntBetween(0, 23),
 		},
 		Description:   "List of hours to execute the job at if running on a schedule",
 		ConflictsWith: []string{"schedule_interval"},
@@ -119,14 +123,31 @@ var jobSchema = map[string]*schema.Schema{
 		MinItems: 1,
 		Optional: true,
 		Elem: &schema.Schema{
-			Type: schema.TypeInt,
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntBetween(0, 7),
 		},
 		Description: "List of days of week as numbers (0 = Sunday, 7 = Saturday) to execute the job at if running on a schedule",
 	},
 	"schedule_cron": &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Custom cron expression for schedule",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Custom cron expression for schedule, evaluated in UTC",
+		ValidateFunc: validateScheduleCron,
+	},
+	"next_run_times": &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "Next UTC times (RFC 3339) the job will run on its schedule, computed locally when the job is read, empty when the schedule trigger is off",
+	},
+	"on_destroy": &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      jobActiveRunsIgnore,
+		Description:  "What to do with runs of the job still queued or running when it's destroyed, or before its environment_id or execute_steps change, one of ignore/ cancel_running/ wait_for_running/ fail_if_running",
+		ValidateFunc: validation.StringInSlice(jobActiveRunsBehaviours, false),
 	},
 }
 
@@ -138,8 +159,17 @@ func ResourceJob() *schema.Resource {
 		DeleteContext: resourceJobDelete,
 
 		Schema: jobSchema,
+		CustomizeDiff: customdiff.All(
+			resourceJobScheduleCustomizeDiff,
+			resourceJobExecuteStepsCustomizeDiff,
+			resourceDbtVersionCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importJob,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(jobActiveRunsTimeout),
+			Delete: schema.DefaultTimeout(jobActiveRunsTimeout),
 		},
 	}
 }
@@ -216,6 +246,27 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		return diag.FromErr(err)
 	}
 
+	hours := []int{}
+	if job.Schedule.Time.Hours != nil {
+		hours = *job.Schedule.Time.Hours
+	}
+	days := []int{}
+	if job.Schedule.Date.Days != nil {
+		days = *job.Schedule.Date.Days
+	}
+	cron := ""
+	if job.Schedule.Date.Cron != nil {
+		cron = *job.Schedule.Date.Cron
+	}
+	// A schedule we can't interpret shouldn't block reading the job itself
+	nextRunTimes, err := jobNextRunTimes(job.Triggers.Schedule, jobScheduleCron(job.Schedule.Date.Type, job.Schedule.Time.Interval, hours, days, cron), time.Now())
+	if err != nil {
+		nextRunTimes = []string{}
+	}
+	if err := d.Set("next_run_times", nextRunTimes); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -262,6 +313,9 @@ func resourceJobCreate(ctx context.Context, d *schema.ResourceData, m interface{
 
 	d.SetId(strconv.Itoa(*j.ID))
 
+	diags = append(diags, executeStepsDiagnostics(c, d)...)
+	diags = append(diags, dbtVersionDiagnostics(c, dbtVersion)...)
+
 	resourceJobRead(ctx, d, m)
 
 	return diags
@@ -271,11 +325,23 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 	c := m.(*dbt_cloud.Client)
 	jobId := d.Id()
 
+	var diags diag.Diagnostics
+
 	if d.HasChange("name") || d.HasChange("dbt_version") || d.HasChange("num_threads") ||
 		d.HasChange("target_name") || d.HasChange("execute_steps") || d.HasChange("run_generate_sources") ||
 		d.HasChange("generate_docs") || d.HasChange("triggers") || d.HasChange("schedule_type") ||
 		d.HasChange("schedule_interval") || d.HasChange("schedule_hours") || d.HasChange("schedule_days") ||
-		d.HasChange("schedule_cron") {
+		d.HasChange("schedule_cron") || d.HasChange("environment_id") {
+		if d.HasChanges(jobActiveRunsKeys...) {
+			jobIdInt, err := strconv.Atoi(jobId)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := handleJobActiveRuns(ctx, c, jobIdInt, d.Get("on_destroy").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		job, err := c.GetJob(jobId)
 		if err != nil {
 			return diag.FromErr(err)
@@ -285,6 +351,10 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 			name := d.Get("name").(string)
 			job.Name = name
 		}
+		if d.HasChange("environment_id") {
+			environmentId := d.Get("environment_id").(int)
+			job.Environment_Id = environmentId
+		}
 		if d.HasChange("dbt_version") {
 			dbtVersion := d.Get("dbt_version").(string)
 			job.Dbt_Version = &dbtVersion
@@ -354,7 +424,14 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		}
 	}
 
-	return resourceJobRead(ctx, d, m)
+	if d.HasChange("execute_steps") || d.HasChange("dbt_version") {
+		diags = append(diags, executeStepsDiagnostics(c, d)...)
+	}
+	if d.HasChange("dbt_version") {
+		diags = append(diags, dbtVersionDiagnostics(c, d.Get("dbt_version").(string))...)
+	}
+
+	return append(diags, resourceJobRead(ctx, d, m)...)
 }
 
 func resourceJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -363,6 +440,14 @@ func resourceJobDelete(ctx context.Context, d *schema.ResourceData, m interface{
 
 	var diags diag.Diagnostics
 
+	jobIdInt, err := strconv.Atoi(jobId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := handleJobActiveRuns(ctx, c, jobIdInt, d.Get("on_destroy").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	job, err := c.GetJob(jobId)
 	if err != nil {
 		return diag.FromErr(err)
@@ -53,6 +53,8 @@ func TestAccDbtCloudJobResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("dbt_cloud_job.test_job", "num_threads"),
 					resource.TestCheckResourceAttrSet("dbt_cloud_job.test_job", "run_generate_sources"),
 					resource.TestCheckResourceAttrSet("dbt_cloud_job.test_job", "generate_docs"),
+					resource.TestCheckResourceAttr("dbt_cloud_job.test_job", "next_run_times.#", "5"),
+					resource.TestCheckResourceAttr("dbt_cloud_job.test_job", "on_destroy", "cancel_running"),
 				),
 			},
 			// IMPORT
@@ -60,7 +62,15 @@ func TestAccDbtCloudJobResource(t *testing.T) {
 				ResourceName:            "dbt_cloud_job.test_job",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{},
+				ImportStateVerifyIgnore: []string{"on_destroy"},
+			},
+			// IMPORT BY NAME
+			{
+				ResourceName:            "dbt_cloud_job.test_job",
+				ImportState:             true,
+				ImportStateId:           projectName + "/" + jobName2,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"on_destroy"},
 			},
 		},
 	})
@@ -130,6 +140,7 @@ resource "dbt_cloud_job" "test_job" {
   generate_docs = true
   schedule_type = "every_day"
   schedule_hours = [9, 17]
+  on_destroy = "cancel_running"
 }
 `, projectName, environmentName, jobName)
 }
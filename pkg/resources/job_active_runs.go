@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+const (
+	jobActiveRunsIgnore  = "ignore"
+	jobActiveRunsCancel  = "cancel_running"
+	jobActiveRunsWait    = "wait_for_running"
+	jobActiveRunsFail    = "fail_if_running"
+	jobActiveRunsTimeout = 30 * time.Minute
+)
+
+var jobActiveRunsBehaviours = []string{
+	jobActiveRunsIgnore,
+	jobActiveRunsCancel,
+	jobActiveRunsWait,
+	jobActiveRunsFail,
+}
+
+// Changes that alter what a running job would do, runs in progress are
+// handled per on_destroy before applying them
+var jobActiveRunsKeys = []string{
+	"environment_id",
+	"execute_steps",
+}
+
+// handleJobActiveRuns deals with the queued or running runs of a job before
+// it's deleted or changed, as configured by on_destroy: cancel them, wait for
+// them to finish, or fail.
+func handleJobActiveRuns(ctx context.Context, c *dbt_cloud.Client, jobId int, behaviour string, timeout time.Duration) error {
+	if behaviour == "" || behaviour == jobActiveRunsIgnore {
+		return nil
+	}
+
+	runs, err := c.ListActiveJobRuns(jobId)
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+
+	runIds := make([]string, len(runs))
+	for i, run := range runs {
+		runIds[i] = strconv.Itoa(*run.ID)
+	}
+
+	switch behaviour {
+	case jobActiveRunsFail:
+		return fmt.Errorf("job %d has %d active run(s) (%s), wait for them to finish or change on_destroy", jobId, len(runs), strings.Join(runIds, ", "))
+	case jobActiveRunsCancel:
+		for _, run := range runs {
+			if _, err := c.CancelRun(*run.ID); err != nil {
+				return fmt.Errorf("cancelling run %d of job %d: %w", *run.ID, jobId, err)
+			}
+		}
+	}
+
+	// Whether cancelled or not, wait for the runs to stop so that nothing
+	// still executes against the old job definition
+	deadline := time.Now().Add(timeout)
+	for _, run := range runs {
+		if _, err := waitForRunTerminal(ctx, c, *run.ID, time.Until(deadline)); err != nil {
+			return fmt.Errorf("waiting for run %d of job %d: %w", *run.ID, jobId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var jobRunSchema = map[string]*schema.Schema{
+	"job_id": &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		ForceNew:    true,
+		Description: "ID of the job to run",
+	},
+	"cause": &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "Triggered by Terraform",
+		Description: "Reason for the run, shown in dbt Cloud",
+	},
+	"git_sha": &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		Description:   "Git commit SHA to run the job against",
+		ConflictsWith: []string{"git_branch"},
+	},
+	"git_branch": &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		Description:   "Git branch to run the job against",
+		ConflictsWith: []string{"git_sha"},
+	},
+	"steps_override": &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateExecuteStep,
+		},
+		Description: "Commands to run instead of the job's execute steps, checked like the execute_steps of jobs according to the provider's execute_steps_validation",
+	},
+	"threads_override": &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Number of threads to use instead of the job's setting",
+	},
+	"target_name_override": &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Target name to use instead of the job's setting",
+	},
+	"wait_for_completion": &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to wait for the run to finish, failing the apply if the run doesn't succeed",
+	},
+	"triggers": &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "Arbitrary values that trigger a new run whenever they change",
+	},
+	"run_id": &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of the run",
+	},
+	"status": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Status of the run, e.g. Queued, Running, Success, Error or Cancelled",
+	},
+	"status_message": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Message dbt Cloud attached to the run status, usually the error",
+	},
+	"run_url": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "URL of the run in dbt Cloud",
+	},
+}
+
+func ResourceJobRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJobRunCreate,
+		ReadContext:   resourceJobRunRead,
+		UpdateContext: resourceJobRunUpdate,
+		DeleteContext: resourceJobRunDelete,
+
+		Schema:        jobRunSchema,
+		CustomizeDiff: resourceJobRunStepsCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceJobRunCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	options := dbt_cloud.JobRunOptions{
+		Cause: d.Get("cause").(string),
+	}
+	if gitSha := d.Get("git_sha").(string); gitSha != "" {
+		options.GitSha = &gitSha
+	}
+	if gitBranch := d.Get("git_branch").(string); gitBranch != "" {
+		options.GitBranch = &gitBranch
+	}
+	if stepsOverride := d.Get("steps_override").([]interface{}); len(stepsOverride) > 0 {
+		steps := make([]string, len(stepsOverride))
+		for i, step := range stepsOverride {
+			steps[i] = step.(string)
+		}
+		options.StepsOverride = &steps
+	}
+	if threadsOverride := d.Get("threads_override").(int); threadsOverride > 0 {
+		options.ThreadsOverride = &threadsOverride
+	}
+	if targetNameOverride := d.Get("target_name_override").(string); targetNameOverride != "" {
+		options.TargetNameOverride = &targetNameOverride
+	}
+
+	run, err := c.TriggerJobRun(d.Get("job_id").(int), options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*run.ID))
+
+	if d.Get("wait_for_completion").(bool) {
+		if err := waitForRun(ctx, c, *run.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			// Keep the run in state, the failed create taints it so the next
+			// apply triggers a new one
+			resourceJobRunRead(ctx, d, m)
+			return diag.FromErr(err)
+		}
+	}
+
+	resourceJobRunRead(ctx, d, m)
+
+	return diags
+}
+
+// waitForRun polls a run until it reaches a terminal status, returning an
+// error unless it succeeded.
+func waitForRun(ctx context.Context, c *dbt_cloud.Client, runId int, timeout time.Duration) error {
+	run, err := waitForRunTerminal(ctx, c, runId, timeout)
+	if err != nil {
+		return err
+	}
+	if run.Status != dbt_cloud.RUN_STATUS_SUCCESS {
+		message := ""
+		if run.StatusMessage != nil {
+			message = ": " + *run.StatusMessage
+		}
+		return fmt.Errorf("run %d finished with status %s%s (%s)", runId, run.StatusHumanized, message, run.Href)
+	}
+	return nil
+}
+
+// waitForRunTerminal polls a run until it reaches a terminal status,
+// whichever it is.
+func waitForRunTerminal(ctx context.Context, c *dbt_cloud.Client, runId int, timeout time.Duration) (*dbt_cloud.Run, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			strconv.Itoa(dbt_cloud.RUN_STATUS_QUEUED),
+			strconv.Itoa(dbt_cloud.RUN_STATUS_STARTING),
+			strconv.Itoa(dbt_cloud.RUN_STATUS_RUNNING),
+		},
+		Target: []string{
+			strconv.Itoa(dbt_cloud.RUN_STATUS_SUCCESS),
+			strconv.Itoa(dbt_cloud.RUN_STATUS_ERROR),
+			strconv.Itoa(dbt_cloud.RUN_STATUS_CANCELLED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			run, err := c.GetRun(runId)
+			if err != nil {
+				return nil, "", err
+			}
+			return run, strconv.Itoa(run.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	run, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return run.(*dbt_cloud.Run), nil
+}
+
+func resourceJobRunRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	runId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	run, err := c.GetRun(runId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("job_id", run.JobDefinitionID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("run_id", run.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", run.StatusHumanized); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status_message", run.StatusMessage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("run_url", run.Href); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceJobRunUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Every argument but wait_for_completion forces a new run, and that one
+	// only matters when the run is triggered
+	return resourceJobRunRead(ctx, d, m)
+}
+
+func resourceJobRunDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Runs are history in dbt Cloud and can't be deleted, just forget it
+	d.SetId("")
+
+	return diags
+}
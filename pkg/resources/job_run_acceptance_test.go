@@ -0,0 +1,105 @@
+package resources_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudJobRunResource(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudJobRunResourceBasicConfig(projectName, environmentName, jobName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudJobRunExists("dbt_cloud_job_run.test_run"),
+					resource.TestCheckResourceAttrSet("dbt_cloud_job_run.test_run", "run_id"),
+					resource.TestCheckResourceAttrSet("dbt_cloud_job_run.test_run", "status"),
+					resource.TestCheckResourceAttrSet("dbt_cloud_job_run.test_run", "run_url"),
+				),
+			},
+			// RERUN
+			{
+				Config: testAccDbtCloudJobRunResourceBasicConfig(projectName, environmentName, jobName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudJobRunExists("dbt_cloud_job_run.test_run"),
+					resource.TestCheckResourceAttr("dbt_cloud_job_run.test_run", "triggers.release", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudJobRunResourceBasicConfig(projectName, environmentName, jobName, release string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "0.21.0"
+  project_id = dbt_cloud_project.test_project.id
+}
+
+resource "dbt_cloud_job" "test_job" {
+  name        = "%s"
+  project_id = dbt_cloud_project.test_project.id
+  environment_id = dbt_cloud_environment.test_env.environment_id
+  execute_steps = [
+    "dbt run"
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": false,
+    "custom_branch_only": false,
+  }
+}
+
+resource "dbt_cloud_job_run" "test_run" {
+  job_id = dbt_cloud_job.test_job.id
+  cause = "Terraform acceptance test"
+  steps_override = ["dbt run --full-refresh"]
+  triggers = {
+    release = "%s"
+  }
+}
+`, projectName, environmentName, jobName, release)
+}
+
+func testAccCheckDbtCloudJobRunExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		runId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get runId")
+		}
+		_, err = apiClient.GetRun(runId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Number of upcoming fire times exposed in next_run_times
+const jobNextRunTimesCount = 5
+
+var jobScheduleKeys = []string{
+	"triggers",
+	"schedule_type",
+	"schedule_interval",
+	"schedule_hours",
+	"schedule_days",
+	"schedule_cron",
+}
+
+func validateScheduleCron(val interface{}, key string) (warns []string, errs []error) {
+	if _, err := dbt_cloud.ParseCron(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid cron expression: %s", key, err))
+	}
+	return
+}
+
+// jobScheduleCron returns the cron expression dbt Cloud runs a job on for the
+// given schedule settings. Schedules built from hours and days always fire on
+// the hour.
+func jobScheduleCron(scheduleType string, scheduleInterval int, scheduleHours []int, scheduleDays []int, scheduleCron string) string {
+	if scheduleType == "custom_cron" {
+		return scheduleCron
+	}
+
+	hours := "*"
+	if len(scheduleHours) > 0 {
+		hours = joinInts(scheduleHours)
+	} else if scheduleInterval > 1 {
+		hours = fmt.Sprintf("*/%d", scheduleInterval)
+	}
+
+	days := "*"
+	if scheduleType == "days_of_week" && len(scheduleDays) > 0 {
+		days = joinInts(scheduleDays)
+	}
+
+	return fmt.Sprintf("0 %s * * %s", hours, days)
+}
+
+// jobNextRunTimes computes the next fire times of a job after from, formatted
+// as RFC 3339 UTC timestamps. Jobs without the schedule trigger never fire.
+func jobNextRunTimes(scheduled bool, cron string, from time.Time) ([]string, error) {
+	nextRunTimes := []string{}
+	if !scheduled {
+		return nextRunTimes, nil
+	}
+
+	schedule, err := dbt_cloud.ParseCron(cron)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range schedule.NextN(from, jobNextRunTimesCount) {
+		nextRunTimes = append(nextRunTimes, t.Format(time.RFC3339))
+	}
+
+	return nextRunTimes, nil
+}
+
+func resourceJobScheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	scheduleType := d.Get("schedule_type").(string)
+	scheduleCron := d.Get("schedule_cron").(string)
+
+	if scheduleType == "custom_cron" && scheduleCron == "" && d.NewValueKnown("schedule_cron") {
+		return fmt.Errorf("schedule_cron must be set when schedule_type is custom_cron")
+	}
+
+	changed := d.Id() == ""
+	for _, key := range jobScheduleKeys {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		changed = changed || d.HasChange(key)
+	}
+	if !changed {
+		return nil
+	}
+
+	triggers := d.Get("triggers").(map[string]interface{})
+	if scheduled, _ := triggers["schedule"].(bool); !scheduled {
+		return nil
+	}
+	cron := jobScheduleCron(
+		scheduleType,
+		d.Get("schedule_interval").(int),
+		interfaceSliceToInts(d.Get("schedule_hours").([]interface{})),
+		interfaceSliceToInts(d.Get("schedule_days").([]interface{})),
+		scheduleCron,
+	)
+
+	// next_run_times moves with the clock, so it is only refreshed by Read
+	// and never planned here
+	if _, err := dbt_cloud.ParseCron(cron); err != nil {
+		return fmt.Errorf("invalid job schedule %q: %s", cron, err)
+	}
+
+	return nil
+}
+
+func interfaceSliceToInts(values []interface{}) []int {
+	ints := []int{}
+	for _, value := range values {
+		ints = append(ints, value.(int))
+	}
+	return ints
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = strconv.Itoa(value)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateExecuteStep warns about the problems of a step that don't depend
+// on the dbt version. The provider configuration isn't known yet when
+// validating, so in error mode the CustomizeDiff fails the plan and counts
+// these warnings rather than repeating them.
+func validateExecuteStep(val interface{}, key string) (warns []string, errs []error) {
+	for _, err := range dbt_cloud.ValidateCommand(val.(string), "") {
+		warns = append(warns, err.Error())
+	}
+	return
+}
+
+func resourceJobExecuteStepsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	if c.ExecuteStepsValidation != dbt_cloud.CommandValidationError {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("execute_steps") && !d.HasChange("dbt_version") {
+		return nil
+	}
+	if !d.NewValueKnown("execute_steps") || !d.NewValueKnown("dbt_version") {
+		return nil
+	}
+
+	return executeStepsError("execute_steps", d.Get("execute_steps").([]interface{}), jobDbtVersion(c, d))
+}
+
+// resourceJobRunStepsCustomizeDiff applies execute_steps_validation to the
+// steps_override of a run, against the dbt version of its job.
+func resourceJobRunStepsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	if c.ExecuteStepsValidation != dbt_cloud.CommandValidationError {
+		return nil
+	}
+	// Every setting of a run forces a new one, existing runs are left alone
+	if d.Id() != "" && !d.HasChange("steps_override") && !d.HasChange("job_id") {
+		return nil
+	}
+	if !d.NewValueKnown("steps_override") || !d.NewValueKnown("job_id") {
+		return nil
+	}
+
+	steps := d.Get("steps_override").([]interface{})
+	if len(steps) == 0 {
+		return nil
+	}
+	return executeStepsError("steps_override", steps, jobRunDbtVersion(c, d.Get("job_id").(int)))
+}
+
+// executeStepsError fails when steps have problems. Only the problems that
+// depend on dbtVersion are listed, validateExecuteStep already warned about
+// the others.
+func executeStepsError(key string, steps []interface{}, dbtVersion string) error {
+	warned := 0
+	messages := []string{}
+	for _, step := range steps {
+		warned += len(dbt_cloud.ValidateCommand(step.(string), ""))
+		for _, err := range dbt_cloud.ValidateCommandVersion(step.(string), dbtVersion) {
+			messages = append(messages, err.Error())
+		}
+	}
+	if warned > 0 {
+		messages = append(messages, fmt.Sprintf("%d problem(s) shown in the warnings about %s", warned, key))
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid %s:\n  %s", key, strings.Join(messages, "\n  "))
+}
+
+// executeStepsDiagnostics returns, in warning mode, the problems of
+// execute_steps that depend on the dbt version of the job. validateExecuteStep
+// only sees the step, so these are the ones it couldn't report.
+func executeStepsDiagnostics(c *dbt_cloud.Client, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c.ExecuteStepsValidation == dbt_cloud.CommandValidationError {
+		return diags
+	}
+
+	dbtVersion := jobDbtVersion(c, d)
+	for _, step := range d.Get("execute_steps").([]interface{}) {
+		for _, err := range dbt_cloud.ValidateCommandVersion(step.(string), dbtVersion) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Invalid execute step",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return diags
+}
+
+type jobGetter interface {
+	Get(key string) interface{}
+}
+
+// jobDbtVersion returns the dbt version a job runs with, falling back to the
+// version of its environment when the job doesn't pin one.
+func jobDbtVersion(c *dbt_cloud.Client, d jobGetter) string {
+	return environmentDbtVersion(c, d.Get("dbt_version").(string), d.Get("project_id").(int), d.Get("environment_id").(int))
+}
+
+// jobRunDbtVersion returns the dbt version the job of a run uses, empty when
+// the job can't be read.
+func jobRunDbtVersion(c *dbt_cloud.Client, jobId int) string {
+	job, err := c.GetJob(strconv.Itoa(jobId))
+	if err != nil {
+		return ""
+	}
+	dbtVersion := ""
+	if job.Dbt_Version != nil {
+		dbtVersion = *job.Dbt_Version
+	}
+	return environmentDbtVersion(c, dbtVersion, job.Project_Id, job.Environment_Id)
+}
+
+func environmentDbtVersion(c *dbt_cloud.Client, dbtVersion string, projectId int, environmentId int) string {
+	if dbtVersion != "" {
+		return dbtVersion
+	}
+	if projectId == 0 || environmentId == 0 {
+		return ""
+	}
+
+	// The environment may not exist yet, in which case only version
+	// independent checks are run
+	environment, err := c.GetEnvironment(projectId, environmentId)
+	if err != nil {
+		return ""
+	}
+	return environment.Dbt_Version
+}
@@ -0,0 +1,240 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceLicenseMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLicenseMapCreate,
+		ReadContext:   resourceLicenseMapRead,
+		UpdateContext: resourceLicenseMapUpdate,
+		DeleteContext: resourceLicenseMapDelete,
+		CustomizeDiff: resourceLicenseMapCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"license_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "License given to the users of the SSO groups, developer, read_only or it",
+				ValidateFunc: validation.StringInSlice(dbt_cloud.LicenseTypes, false),
+			},
+			"sso_license_mapping_groups": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "SSO groups whose users get the license",
+			},
+			"fail_on_full_seats": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to fail the plan instead of warning on apply when SSO groups are added while all the seats of the license type are used",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importLicenseMap,
+		},
+	}
+}
+
+// licenseSeats returns how many seats of a license type the account has and
+// how many are used, limited is false when the account has no seat limit.
+func licenseSeats(c *dbt_cloud.Client, licenseType string) (used int, seats int, limited bool, err error) {
+	account, err := c.GetAccount()
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("getting the account failed: %s", err)
+	}
+	seats, limited = account.Seats(licenseType)
+	if !limited {
+		return 0, 0, false, nil
+	}
+
+	counts, err := c.CountLicensedUsers()
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("listing users failed: %s", err)
+	}
+	return counts[licenseType], seats, true, nil
+}
+
+// licenseMapChangeGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff
+type licenseMapChangeGetter interface {
+	GetChange(key string) (interface{}, interface{})
+}
+
+// addedLicenseMapGroups returns the SSO groups a plan or apply adds to the
+// license map, all of them for new license maps.
+func addedLicenseMapGroups(d licenseMapChangeGetter) []string {
+	old, new := d.GetChange("sso_license_mapping_groups")
+	return interfaceSliceToStrings(new.(*schema.Set).Difference(old.(*schema.Set)).List())
+}
+
+// resourceLicenseMapCustomizeDiff fails the plan when fail_on_full_seats is
+// set and SSO groups are added while no seat of the license type is left for
+// one more user.
+func resourceLicenseMapCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	if !d.Get("fail_on_full_seats").(bool) {
+		return nil
+	}
+	if !d.NewValueKnown("license_type") || !d.NewValueKnown("sso_license_mapping_groups") {
+		return nil
+	}
+	groups := addedLicenseMapGroups(d)
+	if len(groups) == 0 {
+		return nil
+	}
+
+	licenseType := d.Get("license_type").(string)
+	used, seats, limited, err := licenseSeats(c, licenseType)
+	if err != nil {
+		return fmt.Errorf("can't check %s seats, %s", licenseType, err)
+	}
+	if !limited || used+1 <= seats {
+		return nil
+	}
+	return fmt.Errorf("%d of the %d %s seats of the account are used, users of the SSO groups %s who don't have a %s license yet may not get one when they log in", used, seats, licenseType, strings.Join(groups, ", "), licenseType)
+}
+
+// licenseMapSeatDiagnostics warns once the license map is applied when the
+// seats of a license type are all used already, so users of newly mapped SSO
+// groups may not get one.
+func licenseMapSeatDiagnostics(c *dbt_cloud.Client, licenseType string, groups []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(groups) == 0 {
+		return diags
+	}
+
+	used, seats, limited, err := licenseSeats(c, licenseType)
+	if err != nil {
+		log.Printf("[WARN] Can't check %s seats, %s", licenseType, err)
+		return diags
+	}
+	if !limited || used < seats {
+		return diags
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("No %s seats left", licenseType),
+		Detail:   fmt.Sprintf("%d of the %d %s seats of the account are used. Users of the SSO groups %s who don't have a %s license yet may not get one when they log in.", used, seats, licenseType, strings.Join(groups, ", "), licenseType),
+	})
+}
+
+func resourceLicenseMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	licenseType := d.Get("license_type").(string)
+	groups := interfaceSliceToStrings(d.Get("sso_license_mapping_groups").(*schema.Set).List())
+
+	licenseMap, err := c.CreateLicenseMap(licenseType, groups)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*licenseMap.ID))
+
+	diags = append(diags, licenseMapSeatDiagnostics(c, licenseType, groups)...)
+
+	resourceLicenseMapRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceLicenseMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	licenseMapId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	licenseMap, err := c.GetLicenseMap(licenseMapId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("license_type", licenseMap.LicenseType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sso_license_mapping_groups", licenseMap.SSOLicenseMappingGroups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceLicenseMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	licenseMapId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("sso_license_mapping_groups") {
+		licenseMap, err := c.GetLicenseMap(licenseMapId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		groups := interfaceSliceToStrings(d.Get("sso_license_mapping_groups").(*schema.Set).List())
+		licenseMap.SSOLicenseMappingGroups = groups
+
+		_, err = c.UpdateLicenseMap(licenseMapId, *licenseMap)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Only the newly mapped groups can take more seats
+		diags = append(diags, licenseMapSeatDiagnostics(c, licenseMap.LicenseType, addedLicenseMapGroups(d))...)
+	}
+
+	return append(diags, resourceLicenseMapRead(ctx, d, m)...)
+}
+
+func resourceLicenseMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	licenseMapId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteLicenseMap(licenseMapId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,109 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudLicenseMapResource(t *testing.T) {
+
+	groupName := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	groupName2 := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudLicenseMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudLicenseMapResourceConfig(fmt.Sprintf(`["%s"]`, groupName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudLicenseMapExists("dbt_cloud_license_map.test_license_map"),
+					resource.TestCheckResourceAttr("dbt_cloud_license_map.test_license_map", "license_type", "read_only"),
+					resource.TestCheckResourceAttr("dbt_cloud_license_map.test_license_map", "sso_license_mapping_groups.#", "1"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudLicenseMapResourceConfig(fmt.Sprintf(`["%s", "%s"]`, groupName, groupName2)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudLicenseMapExists("dbt_cloud_license_map.test_license_map"),
+					resource.TestCheckResourceAttr("dbt_cloud_license_map.test_license_map", "sso_license_mapping_groups.#", "2"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_license_map.test_license_map",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudLicenseMapResourceConfig(groups string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_license_map" "test_license_map" {
+  license_type               = "read_only"
+  sso_license_mapping_groups = %s
+}
+`, groups)
+}
+
+func testAccCheckDbtCloudLicenseMapExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		licenseMapId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get licenseMapId")
+		}
+
+		_, err = apiClient.GetLicenseMap(licenseMapId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudLicenseMapDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_license_map" {
+			continue
+		}
+		licenseMapId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get licenseMapId")
+		}
+
+		_, err = apiClient.GetLicenseMap(licenseMapId)
+		if err == nil {
+			return fmt.Errorf("License map still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
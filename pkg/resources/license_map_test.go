@@ -0,0 +1,64 @@
+package resources_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestLicenseMapFailOnFullSeats(t *testing.T) {
+	account := `{"data": {"id": 1, "developer_seats": 2, "read_only_seats": 0}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/accounts/1/":
+			w.Write([]byte(account))
+		case "/v3/accounts/1/users/":
+			w.Write([]byte(`{"data": [
+				{"id": 1, "permissions": [{"account_id": 1, "state": 1, "license_type": "developer"}]},
+				{"id": 2, "permissions": [{"account_id": 1, "state": 1, "license_type": "developer"}]}
+			], "extra": {"pagination": {"count": 2, "total_count": 2}}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := &dbt_cloud.Client{HostURL: server.URL, HTTPClient: server.Client(), AccountID: 1}
+	r := resources.ResourceLicenseMap()
+
+	config := func(licenseType string, failOnFullSeats bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"license_type":               licenseType,
+			"sso_license_mapping_groups": []interface{}{"analysts"},
+			"fail_on_full_seats":         failOnFullSeats,
+		})
+	}
+
+	// All developer seats are used
+	_, err := r.Diff(context.Background(), nil, config(dbt_cloud.LICENSE_TYPE_DEVELOPER, true), c)
+	if err == nil || !strings.Contains(err.Error(), "2 of the 2 developer seats") {
+		t.Errorf("expected the plan to fail on full developer seats, got %v", err)
+	}
+
+	// Not opted in, only warned on apply
+	if _, err := r.Diff(context.Background(), nil, config(dbt_cloud.LICENSE_TYPE_DEVELOPER, false), c); err != nil {
+		t.Errorf("expected no error without fail_on_full_seats, got %s", err)
+	}
+
+	// Read only seats aren't limited
+	if _, err := r.Diff(context.Background(), nil, config(dbt_cloud.LICENSE_TYPE_READ_ONLY, true), c); err != nil {
+		t.Errorf("expected no error for unlimited seats, got %s", err)
+	}
+
+	// One seat left for one more user
+	account = `{"data": {"id": 1, "developer_seats": 3, "read_only_seats": 0}}`
+	if _, err := r.Diff(context.Background(), nil, config(dbt_cloud.LICENSE_TYPE_DEVELOPER, true), c); err != nil {
+		t.Errorf("expected no error with a seat left, got %s", err)
+	}
+}
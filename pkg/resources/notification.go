@@ -0,0 +1,271 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var notificationJobKeys = []string{"on_success", "on_failure", "on_cancel", "on_warning"}
+
+func ResourceNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNotificationCreate,
+		ReadContext:   resourceNotificationRead,
+		UpdateContext: resourceNotificationUpdate,
+		DeleteContext: resourceNotificationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the user notified by internal notifications, or of the user owning external and Slack notifications",
+			},
+			"notification_type": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      dbt_cloud.NOTIFICATION_TYPE_INTERNAL,
+				Description:  "Target of the notification, 1 for the internal user, 2 for a Slack channel or 4 for an external email",
+				ValidateFunc: validation.IntInSlice(dbt_cloud.NotificationTypes),
+			},
+			"on_success": notificationJobIdsSchema("IDs of the jobs notified about when their runs succeed"),
+			"on_failure": notificationJobIdsSchema("IDs of the jobs notified about when their runs fail"),
+			"on_cancel":  notificationJobIdsSchema("IDs of the jobs notified about when their runs are cancelled"),
+			"on_warning": notificationJobIdsSchema("IDs of the jobs notified about when their runs succeed with warnings"),
+			"external_email": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Email notified, required for external notifications",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"slack_channel_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "ID of the Slack channel notified, required for Slack notifications",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"slack_channel_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the Slack channel notified",
+			},
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourceNotificationTargetCustomizeDiff,
+			resourceNotificationJobsCustomizeDiff,
+		),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func notificationJobIdsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeInt},
+		Description: description,
+	}
+}
+
+// resourceNotificationTargetCustomizeDiff checks that the target of the
+// notification type is set, and that no other target is
+func resourceNotificationTargetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("notification_type") || !d.NewValueKnown("external_email") || !d.NewValueKnown("slack_channel_id") {
+		return nil
+	}
+
+	notificationType := d.Get("notification_type").(int)
+	hasEmail := d.Get("external_email").(string) != ""
+	hasSlackChannel := d.Get("slack_channel_id").(string) != ""
+
+	switch notificationType {
+	case dbt_cloud.NOTIFICATION_TYPE_INTERNAL:
+		if hasEmail || hasSlackChannel {
+			return fmt.Errorf("internal notifications are sent to user_id, external_email and slack_channel_id can't be set")
+		}
+	case dbt_cloud.NOTIFICATION_TYPE_EXTERNAL:
+		if !hasEmail || hasSlackChannel {
+			return fmt.Errorf("external notifications require external_email, and slack_channel_id can't be set")
+		}
+	case dbt_cloud.NOTIFICATION_TYPE_SLACK:
+		if !hasSlackChannel || hasEmail {
+			return fmt.Errorf("Slack notifications require slack_channel_id, and external_email can't be set")
+		}
+	}
+	return nil
+}
+
+// resourceNotificationJobsCustomizeDiff checks that the jobs exist and are
+// active, dbt Cloud would otherwise never notify about them
+func resourceNotificationJobsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	changed := d.Id() == ""
+	for _, key := range notificationJobKeys {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		changed = changed || d.HasChange(key)
+	}
+	if !changed {
+		return nil
+	}
+
+	notification := dbt_cloud.Notification{
+		OnSuccess: interfaceSliceToInts(d.Get("on_success").(*schema.Set).List()),
+		OnFailure: interfaceSliceToInts(d.Get("on_failure").(*schema.Set).List()),
+		OnCancel:  interfaceSliceToInts(d.Get("on_cancel").(*schema.Set).List()),
+		OnWarning: interfaceSliceToInts(d.Get("on_warning").(*schema.Set).List()),
+	}
+	for _, jobId := range notification.JobIDs() {
+		if err := c.CheckAccountJob(jobId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func expandNotification(d *schema.ResourceData, notification *dbt_cloud.Notification) {
+	notification.UserID = d.Get("user_id").(int)
+	notification.NotificationType = d.Get("notification_type").(int)
+	notification.OnSuccess = interfaceSliceToInts(d.Get("on_success").(*schema.Set).List())
+	notification.OnFailure = interfaceSliceToInts(d.Get("on_failure").(*schema.Set).List())
+	notification.OnCancel = interfaceSliceToInts(d.Get("on_cancel").(*schema.Set).List())
+	notification.OnWarning = interfaceSliceToInts(d.Get("on_warning").(*schema.Set).List())
+	notification.ExternalEmail = nil
+	if externalEmail := d.Get("external_email").(string); externalEmail != "" {
+		notification.ExternalEmail = &externalEmail
+	}
+	notification.SlackChannelID = nil
+	if slackChannelId := d.Get("slack_channel_id").(string); slackChannelId != "" {
+		notification.SlackChannelID = &slackChannelId
+	}
+	notification.SlackChannelName = nil
+	if slackChannelName := d.Get("slack_channel_name").(string); slackChannelName != "" {
+		notification.SlackChannelName = &slackChannelName
+	}
+}
+
+func resourceNotificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	notification := dbt_cloud.Notification{}
+	expandNotification(d, &notification)
+
+	createdNotification, err := c.CreateNotification(notification)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*createdNotification.ID))
+
+	resourceNotificationRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceNotificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	notificationId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notification, err := c.GetNotification(notificationId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("user_id", notification.UserID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("notification_type", notification.NotificationType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("on_success", notification.OnSuccess); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("on_failure", notification.OnFailure); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("on_cancel", notification.OnCancel); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("on_warning", notification.OnWarning); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("external_email", notification.ExternalEmail); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("slack_channel_id", notification.SlackChannelID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("slack_channel_name", notification.SlackChannelName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceNotificationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	notificationId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("user_id") || d.HasChange("notification_type") || d.HasChange("on_success") || d.HasChange("on_failure") || d.HasChange("on_cancel") || d.HasChange("on_warning") || d.HasChange("external_email") || d.HasChange("slack_channel_id") || d.HasChange("slack_channel_name") {
+		notification, err := c.GetNotification(notificationId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		expandNotification(d, notification)
+
+		_, err = c.UpdateNotification(notificationId, *notification)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNotificationRead(ctx, d, m)
+}
+
+func resourceNotificationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	notificationId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteNotification(notificationId)
+	if err != nil && !errors.Is(err, dbt_cloud.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,185 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudNotificationResource(t *testing.T) {
+
+	userEmail := os.Getenv("DBT_CLOUD_USER_EMAIL")
+	if userEmail == "" {
+		t.Skip("DBT_CLOUD_USER_EMAIL must be set to the email of a user of the account")
+	}
+
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudNotificationResourceConfig(userEmail, projectName, environmentName, jobName, `
+  on_failure = [dbt_cloud_job.test_job.id]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudNotificationExists("dbt_cloud_notification.test_notification"),
+					resource.TestCheckResourceAttr("dbt_cloud_notification.test_notification", "notification_type", "1"),
+					resource.TestCheckResourceAttr("dbt_cloud_notification.test_notification", "on_failure.#", "1"),
+					resource.TestCheckResourceAttr("dbt_cloud_notification.test_notification", "on_success.#", "0"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudNotificationResourceConfig(userEmail, projectName, environmentName, jobName, `
+  notification_type = 4
+  external_email    = "alerts@example.com"
+  on_failure        = [dbt_cloud_job.test_job.id]
+  on_cancel         = [dbt_cloud_job.test_job.id]
+  on_warning        = [dbt_cloud_job.test_job.id]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudNotificationExists("dbt_cloud_notification.test_notification"),
+					resource.TestCheckResourceAttr("dbt_cloud_notification.test_notification", "notification_type", "4"),
+					resource.TestCheckResourceAttr("dbt_cloud_notification.test_notification", "external_email", "alerts@example.com"),
+					resource.TestCheckResourceAttr("dbt_cloud_notification.test_notification", "on_cancel.#", "1"),
+					resource.TestCheckResourceAttr("dbt_cloud_notification.test_notification", "on_warning.#", "1"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_notification.test_notification",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudNotificationResourceValidation(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "dbt_cloud_notification" "test_notification" {
+  user_id           = 1
+  notification_type = 2
+}
+`,
+				ExpectError: regexp.MustCompile("Slack notifications require slack_channel_id"),
+			},
+			{
+				Config: `
+resource "dbt_cloud_notification" "test_notification" {
+  user_id    = 1
+  on_failure = [999999999]
+}
+`,
+				ExpectError: regexp.MustCompile("job 999999999"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudNotificationResourceConfig(userEmail, projectName, environmentName, jobName, notification string) string {
+	return fmt.Sprintf(`
+data "dbt_cloud_user" "test_user" {
+  email = "%s"
+}
+
+resource "dbt_cloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbt_cloud_environment" "test_environment" {
+  project_id  = dbt_cloud_project.test_project.id
+  name        = "%s"
+  dbt_version = "0.21.0"
+  type        = "deployment"
+}
+
+resource "dbt_cloud_job" "test_job" {
+  name           = "%s"
+  project_id     = dbt_cloud_project.test_project.id
+  environment_id = dbt_cloud_environment.test_environment.environment_id
+  execute_steps = [
+    "dbt run"
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": false,
+    "custom_branch_only": false,
+  }
+}
+
+resource "dbt_cloud_notification" "test_notification" {
+  user_id = data.dbt_cloud_user.test_user.user_id
+%s}
+`, userEmail, projectName, environmentName, jobName, notification)
+}
+
+func testAccCheckDbtCloudNotificationExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		notificationId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get notificationId")
+		}
+
+		_, err = apiClient.GetNotification(notificationId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudNotificationDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_notification" {
+			continue
+		}
+		notificationId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get notificationId")
+		}
+
+		_, err = apiClient.GetNotification(notificationId)
+		if err == nil {
+			return fmt.Errorf("Notification still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
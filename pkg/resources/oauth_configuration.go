@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceOAuthConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOAuthConfigurationCreate,
+		ReadContext:   resourceOAuthConfigurationRead,
+		UpdateContext: resourceOAuthConfigurationUpdate,
+		DeleteContext: resourceOAuthConfigurationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Identity provider of the OAuth client, snowflake or entra",
+				ValidateFunc: validation.StringInSlice(dbt_cloud.OAuthConfigurationTypes, false),
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "OAuth configuration name",
+			},
+			"client_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Client ID of the OAuth client",
+			},
+			"client_secret": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Client secret of the OAuth client, never read back from dbt Cloud so changes made outside of Terraform aren't detected",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"authorize_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Authorization URL of the identity provider",
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"token_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Token URL of the identity provider",
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"redirect_uri": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Redirect URI registered with the OAuth client",
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"application_id_uri": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Application ID URI of the Entra ID application, required for entra configurations",
+			},
+		},
+
+		CustomizeDiff: resourceOAuthConfigurationTypeCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceOAuthConfigurationTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("application_id_uri") {
+		return nil
+	}
+
+	hasApplicationIdUri := d.Get("application_id_uri").(string) != ""
+	switch d.Get("type").(string) {
+	case dbt_cloud.OAUTH_CONFIGURATION_TYPE_ENTRA:
+		if !hasApplicationIdUri {
+			return fmt.Errorf("entra OAuth configurations require application_id_uri")
+		}
+	case dbt_cloud.OAUTH_CONFIGURATION_TYPE_SNOWFLAKE:
+		if hasApplicationIdUri {
+			return fmt.Errorf("application_id_uri can only be set for entra OAuth configurations")
+		}
+	}
+	return nil
+}
+
+func expandOAuthConfiguration(d *schema.ResourceData, oauthConfiguration *dbt_cloud.OAuthConfiguration) {
+	oauthConfiguration.Type = d.Get("type").(string)
+	oauthConfiguration.Name = d.Get("name").(string)
+	oauthConfiguration.ClientID = d.Get("client_id").(string)
+	oauthConfiguration.ClientSecret = d.Get("client_secret").(string)
+	oauthConfiguration.AuthorizeURL = d.Get("authorize_url").(string)
+	oauthConfiguration.TokenURL = d.Get("token_url").(string)
+	oauthConfiguration.RedirectURI = d.Get("redirect_uri").(string)
+	oauthConfiguration.ApplicationIDURI = d.Get("application_id_uri").(string)
+}
+
+func resourceOAuthConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	oauthConfiguration := dbt_cloud.OAuthConfiguration{}
+	expandOAuthConfiguration(d, &oauthConfiguration)
+
+	createdOAuthConfiguration, err := c.CreateOAuthConfiguration(oauthConfiguration)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*createdOAuthConfiguration.ID))
+
+	resourceOAuthConfigurationRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceOAuthConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	oauthConfigurationId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oauthConfiguration, err := c.GetOAuthConfiguration(oauthConfigurationId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("type", oauthConfiguration.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", oauthConfiguration.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("client_id", oauthConfiguration.ClientID); err != nil {
+		return diag.FromErr(err)
+	}
+	// client_secret is kept from the configuration
+	if err := d.Set("authorize_url", oauthConfiguration.AuthorizeURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("token_url", oauthConfiguration.TokenURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("redirect_uri", oauthConfiguration.RedirectURI); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("application_id_uri", oauthConfiguration.ApplicationIDURI); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceOAuthConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	oauthConfigurationId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("client_id") || d.HasChange("client_secret") || d.HasChange("authorize_url") || d.HasChange("token_url") || d.HasChange("redirect_uri") || d.HasChange("application_id_uri") {
+		oauthConfiguration, err := c.GetOAuthConfiguration(oauthConfigurationId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		expandOAuthConfiguration(d, oauthConfiguration)
+		// Only rotate the secret when it changed
+		if !d.HasChange("client_secret") {
+			oauthConfiguration.ClientSecret = ""
+		}
+
+		_, err = c.UpdateOAuthConfiguration(oauthConfigurationId, *oauthConfiguration)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceOAuthConfigurationRead(ctx, d, m)
+}
+
+func resourceOAuthConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	oauthConfigurationId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteOAuthConfiguration(oauthConfigurationId)
+	if err != nil && !errors.Is(err, dbt_cloud.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
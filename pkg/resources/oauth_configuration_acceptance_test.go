@@ -0,0 +1,140 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudOAuthConfigurationResource(t *testing.T) {
+
+	oauthConfigurationName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	oauthConfigurationName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudOAuthConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudOAuthConfigurationResourceConfig(oauthConfigurationName, "first-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudOAuthConfigurationExists("dbt_cloud_oauth_configuration.test_oauth_configuration"),
+					resource.TestCheckResourceAttr("dbt_cloud_oauth_configuration.test_oauth_configuration", "name", oauthConfigurationName),
+					resource.TestCheckResourceAttr("dbt_cloud_oauth_configuration.test_oauth_configuration", "type", "snowflake"),
+					resource.TestCheckResourceAttr("dbt_cloud_oauth_configuration.test_oauth_configuration", "client_secret", "first-secret"),
+				),
+			},
+			// MODIFY, rotating the secret
+			{
+				Config: testAccDbtCloudOAuthConfigurationResourceConfig(oauthConfigurationName2, "second-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudOAuthConfigurationExists("dbt_cloud_oauth_configuration.test_oauth_configuration"),
+					resource.TestCheckResourceAttr("dbt_cloud_oauth_configuration.test_oauth_configuration", "name", oauthConfigurationName2),
+					resource.TestCheckResourceAttr("dbt_cloud_oauth_configuration.test_oauth_configuration", "client_secret", "second-secret"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_oauth_configuration.test_oauth_configuration",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudOAuthConfigurationResourceValidation(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "dbt_cloud_oauth_configuration" "test_oauth_configuration" {
+  type          = "entra"
+  name          = "entra"
+  client_id     = "client"
+  client_secret = "secret"
+  authorize_url = "https://login.microsoftonline.com/tenant/oauth2/v2.0/authorize"
+  token_url     = "https://login.microsoftonline.com/tenant/oauth2/v2.0/token"
+  redirect_uri  = "https://cloud.getdbt.com/complete/entra"
+}
+`,
+				ExpectError: regexp.MustCompile("require application_id_uri"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudOAuthConfigurationResourceConfig(oauthConfigurationName, clientSecret string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_oauth_configuration" "test_oauth_configuration" {
+  type          = "snowflake"
+  name          = "%s"
+  client_id     = "client"
+  client_secret = "%s"
+  authorize_url = "https://example.snowflakecomputing.com/oauth/authorize"
+  token_url     = "https://example.snowflakecomputing.com/oauth/token-request"
+  redirect_uri  = "https://cloud.getdbt.com/complete/snowflake"
+}
+`, oauthConfigurationName, clientSecret)
+}
+
+func testAccCheckDbtCloudOAuthConfigurationExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		oauthConfigurationId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get oauthConfigurationId")
+		}
+
+		_, err = apiClient.GetOAuthConfiguration(oauthConfigurationId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudOAuthConfigurationDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_oauth_configuration" {
+			continue
+		}
+		oauthConfigurationId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get oauthConfigurationId")
+		}
+
+		_, err = apiClient.GetOAuthConfiguration(oauthConfigurationId)
+		if err == nil {
+			return fmt.Errorf("OAuth configuration still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
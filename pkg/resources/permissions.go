@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// projectPermission is a permission set granted on all projects, or on the
+// project ProjectID, shared by groups and service tokens
+type projectPermission struct {
+	PermissionSet string
+	AllProjects   bool
+	ProjectID     *int
+}
+
+// projectPermissionsSchema is the schema of blocks of project permissions.
+// It's a set as dbt Cloud returns permissions in no particular order.
+func projectPermissionsSchema(description string, permissionSets []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"permission_set": &schema.Schema{
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Permission set granted, e.g. developer or job_admin",
+					ValidateFunc: validation.StringInSlice(permissionSets, false),
+				},
+				"all_projects": &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Whether the permission set is granted on all projects, instead of project_id",
+				},
+				"project_id": &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Project ID the permission set is granted on, when all_projects is false",
+				},
+			},
+		},
+	}
+}
+
+// expandProjectPermissions converts permission blocks, checking each block
+// grants its permission set either on all projects or on one project
+func expandProjectPermissions(permissions *schema.Set) ([]projectPermission, error) {
+	projectPermissions := []projectPermission{}
+	for _, p := range permissions.List() {
+		permission := p.(map[string]interface{})
+		permissionSet := permission["permission_set"].(string)
+		allProjects := permission["all_projects"].(bool)
+		projectId := permission["project_id"].(int)
+
+		if allProjects && projectId != 0 {
+			return nil, fmt.Errorf("permission %s can't set both all_projects and project_id", permissionSet)
+		}
+		if !allProjects && projectId == 0 {
+			return nil, fmt.Errorf("permission %s needs either all_projects or project_id", permissionSet)
+		}
+
+		projectPermission := projectPermission{
+			PermissionSet: permissionSet,
+			AllProjects:   allProjects,
+		}
+		if projectId != 0 {
+			projectPermission.ProjectID = &projectId
+		}
+		projectPermissions = append(projectPermissions, projectPermission)
+	}
+	return projectPermissions, nil
+}
+
+func flattenProjectPermission(permissionSet string, allProjects bool, projectId *int) map[string]interface{} {
+	id := 0
+	if projectId != nil && !allProjects {
+		id = *projectId
+	}
+	return map[string]interface{}{
+		"permission_set": permissionSet,
+		"all_projects":   allProjects,
+		"project_id":     id,
+	}
+}
@@ -25,7 +25,7 @@ var projectSchema = map[string]*schema.Schema{
 		Type:        schema.TypeInt,
 		Optional:    true,
 		Computed:    true,
-		Description: "Connection ID",
+		Description: "Connection ID, used by the environments of the project that don't set their own connection_id",
 		ConflictsWith: []string{
 			dbt_cloud.TypeBigQueryConnection,
 		},
@@ -86,6 +86,22 @@ var projectSchema = map[string]*schema.Schema{
 								Sensitive:   true,
 								Description: "JSON string representing the private key of the Service Account that will connect to the BigQuery",
 							},
+							"application_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Client ID of the OAuth client developers authenticate with, instead of the Service Account",
+							},
+							"application_secret": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Client secret of the OAuth client developers authenticate with",
+							},
+							"oauth_configuration_id": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "ID of the dbt_cloud_oauth_configuration developers authenticate with, e.g. with Entra ID",
+							},
 						},
 					},
 				},
@@ -131,6 +147,30 @@ func ResourceProject() *schema.Resource {
 	}
 }
 
+// expandBigQueryConnectionDetails builds the connection details from the
+// Service Account private key and the other settings of a bigquery block
+func expandBigQueryConnectionDetails(connection map[string]interface{}) (dbt_cloud.ConnectionDetails, error) {
+	details := connection["details"].(*schema.Set).List()[0].(map[string]interface{})
+	serviceAccountPrivateKey := details["service_account_private_key"].(string)
+
+	detailObject := dbt_cloud.ConnectionDetails{}
+	err := json.Unmarshal([]byte(serviceAccountPrivateKey), &detailObject)
+	if err != nil {
+		return detailObject, err
+	}
+
+	detailObject.Retries = details["retries"].(int)
+	detailObject.Location = details["location"].(string)
+	detailObject.TimeoutSeconds = details["timeout_seconds"].(int)
+	detailObject.ApplicationID = details["application_id"].(string)
+	detailObject.ApplicationSecret = details["application_secret"].(string)
+	if oauthConfigurationID := details["oauth_configuration_id"].(int); oauthConfigurationID != 0 {
+		detailObject.OAuthConfigurationID = &oauthConfigurationID
+	}
+
+	return detailObject, nil
+}
+
 func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*dbt_cloud.Client)
 
@@ -172,19 +212,11 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 	repositoryID := d.Get("repository_id").(int)
 
 	if x := ResourceDataInterfaceMap(d, dbt_cloud.TypeBigQueryConnection); len(x) != 0 {
-		details := x["details"].(*schema.Set).List()[0].(map[string]interface{})
-		serviceAccountPrivateKey := details["service_account_private_key"].(string)
-
-		detailObject := dbt_cloud.ConnectionDetails{}
-		err := json.Unmarshal([]byte(serviceAccountPrivateKey), &detailObject)
+		detailObject, err := expandBigQueryConnectionDetails(x)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		detailObject.Retries = details["retries"].(int)
-		detailObject.Location = details["location"].(string)
-		detailObject.TimeoutSeconds = details["timeout_seconds"].(int)
-
 		connection = &dbt_cloud.Connection{
 			Name:    x["name"].(string),
 			Type:    dbt_cloud.TypeBigQueryConnection,
@@ -276,18 +308,10 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 		if d.HasChange(dbt_cloud.TypeBigQueryConnection) {
 			if x := ResourceDataInterfaceMap(d, dbt_cloud.TypeBigQueryConnection); len(x) != 0 {
-				details := x["details"].(*schema.Set).List()[0].(map[string]interface{})
-				serviceAccountPrivateKey := details["service_account_private_key"].(string)
-
-				detailObject := dbt_cloud.ConnectionDetails{}
-				err := json.Unmarshal([]byte(serviceAccountPrivateKey), &detailObject)
+				detailObject, err := expandBigQueryConnectionDetails(x)
 				if err != nil {
 					return diag.FromErr(err)
 				}
-
-				detailObject.Retries = details["retries"].(int)
-				detailObject.Location = details["location"].(string)
-				detailObject.TimeoutSeconds = details["timeout_seconds"].(int)
 				id := d.Get("connection_id").(int)
 				connection := dbt_cloud.Connection{
 					Details: detailObject,
@@ -0,0 +1,211 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceServiceToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceTokenCreate,
+		ReadContext:   resourceServiceTokenRead,
+		UpdateContext: resourceServiceTokenUpdate,
+		DeleteContext: resourceServiceTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service token name",
+			},
+			"state": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      dbt_cloud.SERVICE_TOKEN_STATE_ACTIVE,
+				Description:  "Service token state, 1 for active or 2 for inactive",
+				ValidateFunc: validation.IntInSlice([]int{dbt_cloud.SERVICE_TOKEN_STATE_ACTIVE, dbt_cloud.SERVICE_TOKEN_STATE_INACTIVE}),
+			},
+			"service_token_permissions": projectPermissionsSchema("Permissions of the service token, each granting a permission set on all projects or on one project", dbt_cloud.ServiceTokenPermissionSets),
+			"uid": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public identifier of the service token",
+			},
+			"token_string": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Service token, only available when the token is created, empty for imported tokens",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandServiceTokenPermissions(permissions *schema.Set) ([]dbt_cloud.ServiceTokenPermission, error) {
+	projectPermissions, err := expandProjectPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceTokenPermissions := []dbt_cloud.ServiceTokenPermission{}
+	for _, permission := range projectPermissions {
+		serviceTokenPermissions = append(serviceTokenPermissions, dbt_cloud.ServiceTokenPermission{
+			PermissionSet: permission.PermissionSet,
+			AllProjects:   permission.AllProjects,
+			ProjectID:     permission.ProjectID,
+		})
+	}
+	return serviceTokenPermissions, nil
+}
+
+func flattenServiceTokenPermissions(permissions []dbt_cloud.ServiceTokenPermission) []interface{} {
+	serviceTokenPermissions := []interface{}{}
+	for _, permission := range permissions {
+		if permission.State == dbt_cloud.STATE_DELETED {
+			continue
+		}
+		serviceTokenPermissions = append(serviceTokenPermissions, flattenProjectPermission(permission.PermissionSet, permission.AllProjects, permission.ProjectID))
+	}
+	return serviceTokenPermissions
+}
+
+func resourceServiceTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	permissions, err := expandServiceTokenPermissions(d.Get("service_token_permissions").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceToken, err := c.CreateServiceToken(d.Get("name").(string), d.Get("state").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*serviceToken.ID))
+
+	// dbt Cloud never returns the token again
+	if err := d.Set("token_string", serviceToken.TokenString); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(permissions) > 0 {
+		_, err = c.UpdateServiceTokenPermissions(*serviceToken.ID, permissions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resourceServiceTokenRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceServiceTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	serviceTokenId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceToken, err := c.GetServiceToken(serviceTokenId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		// Revoked outside of Terraform
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permissions, err := c.GetServiceTokenPermissions(serviceTokenId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", serviceToken.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("state", serviceToken.State); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("uid", serviceToken.UID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("service_token_permissions", flattenServiceTokenPermissions(permissions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceServiceTokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	serviceTokenId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("state") {
+		serviceToken, err := c.GetServiceToken(serviceTokenId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		serviceToken.Name = d.Get("name").(string)
+		serviceToken.State = d.Get("state").(int)
+
+		_, err = c.UpdateServiceToken(serviceTokenId, *serviceToken)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("service_token_permissions") {
+		permissions, err := expandServiceTokenPermissions(d.Get("service_token_permissions").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = c.UpdateServiceTokenPermissions(serviceTokenId, permissions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServiceTokenRead(ctx, d, m)
+}
+
+func resourceServiceTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	serviceTokenId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteServiceToken(serviceTokenId)
+	if err != nil && !errors.Is(err, dbt_cloud.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,140 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudServiceTokenResource(t *testing.T) {
+
+	serviceTokenName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	serviceTokenName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudServiceTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudServiceTokenResourceBasicConfig(serviceTokenName, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudServiceTokenExists("dbt_cloud_service_token.test_service_token"),
+					resource.TestCheckResourceAttr("dbt_cloud_service_token.test_service_token", "name", serviceTokenName),
+					resource.TestCheckResourceAttrSet("dbt_cloud_service_token.test_service_token", "token_string"),
+					resource.TestCheckResourceAttr("dbt_cloud_service_token.test_service_token", "service_token_permissions.#", "1"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudServiceTokenResourceFullConfig(serviceTokenName2, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudServiceTokenExists("dbt_cloud_service_token.test_service_token"),
+					resource.TestCheckResourceAttr("dbt_cloud_service_token.test_service_token", "name", serviceTokenName2),
+					resource.TestCheckResourceAttrSet("dbt_cloud_service_token.test_service_token", "token_string"),
+					resource.TestCheckResourceAttr("dbt_cloud_service_token.test_service_token", "service_token_permissions.#", "2"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_service_token.test_service_token",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token_string"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudServiceTokenResourceBasicConfig(serviceTokenName, projectName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_service_token" "test_service_token" {
+  name = "%s"
+  service_token_permissions {
+    permission_set = "job_runner"
+    project_id     = dbt_cloud_project.test_project.id
+  }
+}
+`, projectName, serviceTokenName)
+}
+
+func testAccDbtCloudServiceTokenResourceFullConfig(serviceTokenName, projectName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbt_cloud_service_token" "test_service_token" {
+  name = "%s"
+  service_token_permissions {
+    permission_set = "job_runner"
+    project_id     = dbt_cloud_project.test_project.id
+  }
+  service_token_permissions {
+    permission_set = "metadata_only"
+    all_projects   = true
+  }
+}
+`, projectName, serviceTokenName)
+}
+
+func testAccCheckDbtCloudServiceTokenExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		serviceTokenId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get serviceTokenId")
+		}
+
+		_, err = apiClient.GetServiceToken(serviceTokenId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudServiceTokenDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_service_token" {
+			continue
+		}
+		serviceTokenId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get serviceTokenId")
+		}
+
+		_, err = apiClient.GetServiceToken(serviceTokenId)
+		if err == nil {
+			return fmt.Errorf("Service token still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -3,8 +3,6 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -77,7 +75,7 @@ func ResourceSnowflakeCredential() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importProjectScopedResource("credential", resolveCredentialId),
 		},
 	}
 }
@@ -114,12 +112,7 @@ func resourceSnowflakeCredentialRead(ctx context.Context, d *schema.ResourceData
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	snowflakeCredentialId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
+	projectId, snowflakeCredentialId, err := parseProjectScopedId(d.Id(), "credential")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -160,12 +153,7 @@ func resourceSnowflakeCredentialRead(ctx context.Context, d *schema.ResourceData
 func resourceSnowflakeCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*dbt_cloud.Client)
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	snowflakeCredentialId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
+	projectId, snowflakeCredentialId, err := parseProjectScopedId(d.Id(), "credential")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -211,12 +199,7 @@ func resourceSnowflakeCredentialDelete(ctx context.Context, d *schema.ResourceDa
 
 	var diags diag.Diagnostics
 
-	projectId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[0])
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	snowflakeCredentialId, err := strconv.Atoi(strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1])
+	projectId, snowflakeCredentialId, err := parseProjectScopedId(d.Id(), "credential")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceUserGroups() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserGroupsCreate,
+		ReadContext:   resourceUserGroupsRead,
+		UpdateContext: resourceUserGroupsUpdate,
+		DeleteContext: resourceUserGroupsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user, e.g. from the dbt_cloud_user data source",
+			},
+			"group_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of all the groups the user is in, the user is removed from any other group of the account",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandGroupIds(groupIds *schema.Set) []int {
+	ids := []int{}
+	for _, id := range groupIds.List() {
+		ids = append(ids, id.(int))
+	}
+	return ids
+}
+
+func resourceUserGroupsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	userId := d.Get("user_id").(int)
+
+	_, err := c.AssignUserGroups(userId, expandGroupIds(d.Get("group_ids").(*schema.Set)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(userId))
+
+	resourceUserGroupsRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceUserGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	userId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := c.GetUser(userId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		// The user left the account
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("user_id", user.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("group_ids", user.GroupIDs(c.AccountID)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceUserGroupsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	if d.HasChange("group_ids") {
+		_, err := c.AssignUserGroups(d.Get("user_id").(int), expandGroupIds(d.Get("group_ids").(*schema.Set)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceUserGroupsRead(ctx, d, m)
+}
+
+func resourceUserGroupsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	_, err := c.AssignUserGroups(d.Get("user_id").(int), []int{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
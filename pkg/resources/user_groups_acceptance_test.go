@@ -0,0 +1,100 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudUserGroupsResource(t *testing.T) {
+
+	email := os.Getenv("DBT_CLOUD_USER_EMAIL")
+	if email == "" {
+		t.Skip("DBT_CLOUD_USER_EMAIL must be set to the email of a user of the account")
+	}
+	groupName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	groupName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudUserGroupsResourceConfig(email, groupName, groupName2, "[dbt_cloud_group.test_group.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudUserGroupsCount("dbt_cloud_user_groups.test_user_groups", 1),
+					resource.TestCheckResourceAttr("dbt_cloud_user_groups.test_user_groups", "group_ids.#", "1"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudUserGroupsResourceConfig(email, groupName, groupName2, "[dbt_cloud_group.test_group.id, dbt_cloud_group.test_group_2.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudUserGroupsCount("dbt_cloud_user_groups.test_user_groups", 2),
+					resource.TestCheckResourceAttr("dbt_cloud_user_groups.test_user_groups", "group_ids.#", "2"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_user_groups.test_user_groups",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudUserGroupsResourceConfig(email, groupName, groupName2, groupIds string) string {
+	return fmt.Sprintf(`
+data "dbt_cloud_user" "test_user" {
+  email = "%s"
+}
+
+resource "dbt_cloud_group" "test_group" {
+  name = "%s"
+}
+
+resource "dbt_cloud_group" "test_group_2" {
+  name = "%s"
+}
+
+resource "dbt_cloud_user_groups" "test_user_groups" {
+  user_id   = data.dbt_cloud_user.test_user.user_id
+  group_ids = %s
+}
+`, email, groupName, groupName2, groupIds)
+}
+
+func testAccCheckDbtCloudUserGroupsCount(resource string, count int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		userId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get userId")
+		}
+
+		user, err := apiClient.GetUser(userId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		if groupIds := user.GroupIDs(apiClient.AccountID); len(groupIds) != count {
+			return fmt.Errorf("expected user %d to be in %d groups, got %v", userId, count, groupIds)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceUserInvite() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserInviteCreate,
+		ReadContext:   resourceUserInviteRead,
+		DeleteContext: resourceUserInviteDelete,
+
+		Schema: map[string]*schema.Schema{
+			"email": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email the invite is sent to",
+			},
+			"license_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dbt_cloud.LICENSE_TYPE_DEVELOPER,
+				Description:  "License of the user once they accept the invite, developer, read_only or it",
+				ValidateFunc: validation.StringInSlice(dbt_cloud.LicenseTypes, false),
+			},
+			"group_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of the groups the user is added to once they accept the invite",
+			},
+			"accepted": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the invite was accepted",
+			},
+			"user_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the user who accepted the invite, 0 while it is pending",
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceUserInviteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	email := d.Get("email").(string)
+	licenseType := d.Get("license_type").(string)
+	groupIds := expandGroupIds(d.Get("group_ids").(*schema.Set))
+
+	userInvite, err := c.CreateUserInvite(email, licenseType, groupIds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*userInvite.ID))
+
+	resourceUserInviteRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceUserInviteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	inviteId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userInvite, err := c.GetUserInvite(inviteId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		// Revoked outside of Terraform, plan to invite again
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accepted := userInvite.State == dbt_cloud.INVITE_STATE_ACCEPTED
+	userId := 0
+	if accepted && userInvite.UserID != nil {
+		userId = *userInvite.UserID
+	}
+
+	if err := d.Set("email", userInvite.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("license_type", userInvite.LicenseType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("group_ids", userInvite.GroupIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("accepted", accepted); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_id", userId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceUserInviteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	inviteId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userInvite, err := c.GetUserInvite(inviteId)
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Accepted invites can't be revoked, the user has to be removed from
+	// the account instead
+	if userInvite.State == dbt_cloud.INVITE_STATE_ACCEPTED {
+		userId := 0
+		if userInvite.UserID != nil {
+			userId = *userInvite.UserID
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Invite already accepted",
+			Detail:   fmt.Sprintf("The invite of %s was accepted, user %d stays in the account until they are removed from it.", userInvite.Email, userId),
+		})
+	}
+
+	err = c.RevokeUserInvite(inviteId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,109 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudUserInviteResource(t *testing.T) {
+
+	email := fmt.Sprintf("%s@example.com", strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+	groupName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudUserInviteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudUserInviteResourceConfig(email, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudUserInviteExists("dbt_cloud_user_invite.test_invite"),
+					resource.TestCheckResourceAttr("dbt_cloud_user_invite.test_invite", "email", email),
+					resource.TestCheckResourceAttr("dbt_cloud_user_invite.test_invite", "license_type", "read_only"),
+					resource.TestCheckResourceAttr("dbt_cloud_user_invite.test_invite", "group_ids.#", "1"),
+					resource.TestCheckResourceAttr("dbt_cloud_user_invite.test_invite", "accepted", "false"),
+					resource.TestCheckResourceAttr("dbt_cloud_user_invite.test_invite", "user_id", "0"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_user_invite.test_invite",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudUserInviteResourceConfig(email, groupName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_group" "test_group" {
+  name = "%s"
+}
+
+resource "dbt_cloud_user_invite" "test_invite" {
+  email        = "%s"
+  license_type = "read_only"
+  group_ids    = [dbt_cloud_group.test_group.id]
+}
+`, groupName, email)
+}
+
+func testAccCheckDbtCloudUserInviteExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+		inviteId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get inviteId")
+		}
+
+		_, err = apiClient.GetUserInvite(inviteId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudUserInviteDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_user_invite" {
+			continue
+		}
+		inviteId, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't get inviteId")
+		}
+
+		_, err = apiClient.GetUserInvite(inviteId)
+		if err == nil {
+			return fmt.Errorf("Invite still pending")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Webhook name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Webhook description",
+			},
+			"client_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "HTTPS URL the events are posted to",
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"event_types": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(dbt_cloud.WebhookEventTypes, false),
+				},
+				Description: "Events posted to the URL, job.run.started, job.run.completed or job.run.errored",
+			},
+			"job_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of the jobs whose events are posted, all the jobs of the account when empty",
+			},
+			"active": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether events are posted",
+			},
+			"hmac_secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Secret the payloads are signed with, to verify they come from dbt Cloud",
+			},
+		},
+
+		CustomizeDiff: resourceWebhookJobIdsCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// resourceWebhookJobIdsCustomizeDiff checks that the jobs exist in the
+// account, the API would otherwise accept them and never post their events
+func resourceWebhookJobIdsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*dbt_cloud.Client)
+
+	if d.Id() != "" && !d.HasChange("job_ids") {
+		return nil
+	}
+	if !d.NewValueKnown("job_ids") {
+		return nil
+	}
+
+	for _, jobId := range interfaceSliceToInts(d.Get("job_ids").(*schema.Set).List()) {
+		if err := c.CheckAccountJob(jobId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	clientUrl := d.Get("client_url").(string)
+	eventTypes := interfaceSliceToStrings(d.Get("event_types").(*schema.Set).List())
+	jobIds := interfaceSliceToInts(d.Get("job_ids").(*schema.Set).List())
+	active := d.Get("active").(bool)
+
+	webhook, err := c.CreateWebhook(name, description, clientUrl, eventTypes, jobIds, active)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(webhook.ID)
+
+	if err := d.Set("hmac_secret", webhook.HMACSecret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceWebhookRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	webhook, err := c.GetWebhook(d.Id())
+	if errors.Is(err, dbt_cloud.ErrNotFound) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	jobIds := []int{}
+	for _, jobId := range webhook.JobIDs {
+		id, err := strconv.Atoi(jobId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		jobIds = append(jobIds, id)
+	}
+
+	if err := d.Set("name", webhook.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", webhook.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("client_url", webhook.ClientURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("event_types", webhook.EventTypes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("job_ids", jobIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("active", webhook.Active); err != nil {
+		return diag.FromErr(err)
+	}
+	// Keep the secret from creation if the API doesn't return it
+	if webhook.HMACSecret != "" {
+		if err := d.Set("hmac_secret", webhook.HMACSecret); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("client_url") || d.HasChange("event_types") || d.HasChange("job_ids") || d.HasChange("active") {
+		webhook, err := c.GetWebhook(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		webhook.Name = d.Get("name").(string)
+		webhook.Description = d.Get("description").(string)
+		webhook.ClientURL = d.Get("client_url").(string)
+		webhook.EventTypes = interfaceSliceToStrings(d.Get("event_types").(*schema.Set).List())
+		webhook.JobIDs = dbt_cloud.WebhookJobIDs(interfaceSliceToInts(d.Get("job_ids").(*schema.Set).List()))
+		webhook.Active = d.Get("active").(bool)
+
+		_, err = c.UpdateWebhook(d.Id(), *webhook)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceWebhookRead(ctx, d, m)
+}
+
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*dbt_cloud.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteWebhook(d.Id())
+	if err != nil && !errors.Is(err, dbt_cloud.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
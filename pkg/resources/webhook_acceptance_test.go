@@ -0,0 +1,183 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDbtCloudWebhookResource(t *testing.T) {
+
+	webhookName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	webhookName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDbtCloudWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudWebhookResourceBasicConfig(webhookName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudWebhookExists("dbt_cloud_webhook.test_webhook"),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "name", webhookName),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "event_types.#", "1"),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "job_ids.#", "0"),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "active", "true"),
+					resource.TestCheckResourceAttrSet("dbt_cloud_webhook.test_webhook", "hmac_secret"),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudWebhookResourceFullConfig(webhookName2, projectName, environmentName, jobName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudWebhookExists("dbt_cloud_webhook.test_webhook"),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "name", webhookName2),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "description", "Job run events"),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "event_types.#", "3"),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "job_ids.#", "1"),
+					resource.TestCheckResourceAttr("dbt_cloud_webhook.test_webhook", "active", "false"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbt_cloud_webhook.test_webhook",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"hmac_secret"},
+			},
+		},
+	})
+}
+
+func TestAccDbtCloudWebhookResourceValidation(t *testing.T) {
+
+	webhookName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "dbt_cloud_webhook" "test_webhook" {
+  name        = "%s"
+  client_url  = "http://example.com/dbt"
+  event_types = ["job.run.started"]
+}
+`, webhookName),
+				ExpectError: regexp.MustCompile("to have a url with schema of: \"https\""),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "dbt_cloud_webhook" "test_webhook" {
+  name        = "%s"
+  client_url  = "https://example.com/dbt"
+  event_types = ["job.run.started"]
+  job_ids     = [999999999]
+}
+`, webhookName),
+				ExpectError: regexp.MustCompile("job 999999999"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudWebhookResourceBasicConfig(webhookName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_webhook" "test_webhook" {
+  name        = "%s"
+  client_url  = "https://example.com/dbt"
+  event_types = ["job.run.completed"]
+}
+`, webhookName)
+}
+
+func testAccDbtCloudWebhookResourceFullConfig(webhookName, projectName, environmentName, jobName string) string {
+	return fmt.Sprintf(`
+resource "dbt_cloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbt_cloud_environment" "test_environment" {
+  project_id  = dbt_cloud_project.test_project.id
+  name        = "%s"
+  dbt_version = "0.21.0"
+  type        = "deployment"
+}
+
+resource "dbt_cloud_job" "test_job" {
+  name           = "%s"
+  project_id     = dbt_cloud_project.test_project.id
+  environment_id = dbt_cloud_environment.test_environment.environment_id
+  execute_steps = [
+    "dbt run"
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": false,
+    "custom_branch_only": false,
+  }
+}
+
+resource "dbt_cloud_webhook" "test_webhook" {
+  name        = "%s"
+  description = "Job run events"
+  client_url  = "https://example.com/dbt"
+  event_types = ["job.run.started", "job.run.completed", "job.run.errored"]
+  job_ids     = [dbt_cloud_job.test_job.id]
+  active      = false
+}
+`, projectName, environmentName, jobName, webhookName)
+}
+
+func testAccCheckDbtCloudWebhookExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+		_, err := apiClient.GetWebhook(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudWebhookDestroy(s *terraform.State) error {
+	apiClient := testAccProvider.Meta().(*dbt_cloud.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbt_cloud_webhook" {
+			continue
+		}
+
+		_, err := apiClient.GetWebhook(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Webhook still exists")
+		}
+		notFoundErr := "not found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+)
+
+// RunEvent is a job run event posted by a webhook
+type RunEvent struct {
+	AccountID   int
+	WebhookID   string
+	EventID     string
+	Timestamp   time.Time
+	EventType   string
+	WebhookName string
+
+	JobID            int
+	JobName          string
+	RunID            int
+	EnvironmentID    int
+	EnvironmentName  string
+	DbtVersion       string
+	ProjectID        int
+	ProjectName      string
+	RunStatus        string
+	RunStatusCode    int
+	RunStatusMessage string
+	RunReason        string
+	RunStartedAt     time.Time
+	// Zero for job.run.started events
+	RunFinishedAt time.Time
+}
+
+// runEventPayload is the JSON dbt Cloud posts, with IDs as strings
+type runEventPayload struct {
+	AccountID   int                 `json:"accountId"`
+	WebhookID   string              `json:"webhooksID"`
+	EventID     string              `json:"eventId"`
+	Timestamp   time.Time           `json:"timestamp"`
+	EventType   string              `json:"eventType"`
+	WebhookName string              `json:"webhookName"`
+	Data        runEventDataPayload `json:"data"`
+}
+
+type runEventDataPayload struct {
+	JobID            string     `json:"jobId"`
+	JobName          string     `json:"jobName"`
+	RunID            string     `json:"runId"`
+	EnvironmentID    string     `json:"environmentId"`
+	EnvironmentName  string     `json:"environmentName"`
+	DbtVersion       string     `json:"dbtVersion"`
+	ProjectName      string     `json:"projectName"`
+	ProjectID        string     `json:"projectId"`
+	RunStatus        string     `json:"runStatus"`
+	RunStatusCode    int        `json:"runStatusCode"`
+	RunStatusMessage string     `json:"runStatusMessage"`
+	RunReason        string     `json:"runReason"`
+	RunStartedAt     time.Time  `json:"runStartedAt"`
+	RunFinishedAt    *time.Time `json:"runFinishedAt,omitempty"`
+}
+
+// NewRunEvent returns the event a webhook posts for a run, the run times are
+// only set if the run has them
+func NewRunEvent(eventType string, webhook dbt_cloud.Webhook, run dbt_cloud.Run) RunEvent {
+	event := RunEvent{
+		AccountID:     run.AccountID,
+		WebhookID:     webhook.ID,
+		Timestamp:     time.Now().UTC(),
+		EventType:     eventType,
+		WebhookName:   webhook.Name,
+		JobID:         run.JobDefinitionID,
+		EnvironmentID: run.EnvironmentID,
+		DbtVersion:    run.DbtVersion,
+		ProjectID:     run.ProjectID,
+		RunStatus:     run.StatusHumanized,
+		RunStatusCode: run.Status,
+	}
+	if run.ID != nil {
+		event.RunID = *run.ID
+		event.EventID = fmt.Sprintf("wev_%d_%s", *run.ID, eventType)
+	}
+	if run.StatusMessage != nil {
+		event.RunStatusMessage = *run.StatusMessage
+	}
+	if run.Trigger != nil {
+		event.RunReason = run.Trigger.Cause
+	}
+	event.RunStartedAt = run.StartedAtTime()
+	event.RunFinishedAt = run.FinishedAtTime()
+	return event
+}
+
+// RunEventTypes returns the events a webhook posts for a run status, errored
+// runs are also completed
+func RunEventTypes(status int) []string {
+	switch status {
+	case dbt_cloud.RUN_STATUS_ERROR:
+		return []string{dbt_cloud.WEBHOOK_EVENT_JOB_RUN_COMPLETED, dbt_cloud.WEBHOOK_EVENT_JOB_RUN_ERRORED}
+	case dbt_cloud.RUN_STATUS_SUCCESS, dbt_cloud.RUN_STATUS_CANCELLED:
+		return []string{dbt_cloud.WEBHOOK_EVENT_JOB_RUN_COMPLETED}
+	case dbt_cloud.RUN_STATUS_RUNNING:
+		return []string{dbt_cloud.WEBHOOK_EVENT_JOB_RUN_STARTED}
+	}
+	return []string{}
+}
+
+func (e RunEvent) MarshalJSON() ([]byte, error) {
+	payload := runEventPayload{
+		AccountID:   e.AccountID,
+		WebhookID:   e.WebhookID,
+		EventID:     e.EventID,
+		Timestamp:   e.Timestamp,
+		EventType:   e.EventType,
+		WebhookName: e.WebhookName,
+		Data: runEventDataPayload{
+			JobID:            strconv.Itoa(e.JobID),
+			JobName:          e.JobName,
+			RunID:            strconv.Itoa(e.RunID),
+			EnvironmentID:    strconv.Itoa(e.EnvironmentID),
+			EnvironmentName:  e.EnvironmentName,
+			DbtVersion:       e.DbtVersion,
+			ProjectName:      e.ProjectName,
+			ProjectID:        strconv.Itoa(e.ProjectID),
+			RunStatus:        e.RunStatus,
+			RunStatusCode:    e.RunStatusCode,
+			RunStatusMessage: e.RunStatusMessage,
+			RunReason:        e.RunReason,
+			RunStartedAt:     e.RunStartedAt,
+		},
+	}
+	if !e.RunFinishedAt.IsZero() {
+		payload.Data.RunFinishedAt = &e.RunFinishedAt
+	}
+	return json.Marshal(payload)
+}
+
+func (e *RunEvent) UnmarshalJSON(data []byte) error {
+	payload := runEventPayload{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	var err error
+	if e.JobID, err = parseID("jobId", payload.Data.JobID); err != nil {
+		return err
+	}
+	if e.RunID, err = parseID("runId", payload.Data.RunID); err != nil {
+		return err
+	}
+	if e.EnvironmentID, err = parseID("environmentId", payload.Data.EnvironmentID); err != nil {
+		return err
+	}
+	if e.ProjectID, err = parseID("projectId", payload.Data.ProjectID); err != nil {
+		return err
+	}
+
+	e.AccountID = payload.AccountID
+	e.WebhookID = payload.WebhookID
+	e.EventID = payload.EventID
+	e.Timestamp = payload.Timestamp
+	e.EventType = payload.EventType
+	e.WebhookName = payload.WebhookName
+	e.JobName = payload.Data.JobName
+	e.EnvironmentName = payload.Data.EnvironmentName
+	e.DbtVersion = payload.Data.DbtVersion
+	e.ProjectName = payload.Data.ProjectName
+	e.RunStatus = payload.Data.RunStatus
+	e.RunStatusCode = payload.Data.RunStatusCode
+	e.RunStatusMessage = payload.Data.RunStatusMessage
+	e.RunReason = payload.Data.RunReason
+	e.RunStartedAt = payload.Data.RunStartedAt
+	e.RunFinishedAt = time.Time{}
+	if payload.Data.RunFinishedAt != nil {
+		e.RunFinishedAt = *payload.Data.RunFinishedAt
+	}
+	return nil
+}
+
+func parseID(field string, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q in webhook payload", field, value)
+	}
+	return id, nil
+}
+
+// ParseRunEvent decodes a payload, which must be a job run event
+func ParseRunEvent(payload []byte) (*RunEvent, error) {
+	event := RunEvent{}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	for _, eventType := range dbt_cloud.WebhookEventTypes {
+		if event.EventType == eventType {
+			return &event, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown webhook event type %q", event.EventType)
+}
+
+// ReadRunEvent verifies the signature of a request posted by a webhook and
+// decodes its event
+func ReadRunEvent(r *http.Request, secret string) (*RunEvent, error) {
+	payload, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifySignature(payload, r.Header.Get(SIGNATURE_HEADER), secret); err != nil {
+		return nil, err
+	}
+	return ParseRunEvent(payload)
+}
@@ -0,0 +1,76 @@
+package webhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Receiver is an http.Handler collecting the run events posted by a
+// webhook, to test webhook flows without a public URL. Requests with an
+// invalid signature or payload are rejected and never reach Events.
+type Receiver struct {
+	secret string
+	Events chan RunEvent
+}
+
+// NewReceiver returns a receiver for the payloads signed with the secret,
+// buffering up to size events
+func NewReceiver(secret string, size int) *Receiver {
+	return &Receiver{
+		secret: secret,
+		Events: make(chan RunEvent, size),
+	}
+}
+
+func (rcv *Receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "webhooks are posted", http.StatusMethodNotAllowed)
+		return
+	}
+
+	event, err := ReadRunEvent(r, rcv.secret)
+	if err == ErrInvalidSignature {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case rcv.Events <- *event:
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "too many events", http.StatusServiceUnavailable)
+	}
+}
+
+// Send posts a signed event to a webhook URL the way dbt Cloud does, for
+// fake APIs to call when their runs start or finish
+func Send(client *http.Client, url string, secret string, event RunEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SIGNATURE_HEADER, Sign(payload, secret))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("posting %s event to %s, status: %d", event.EventType, url, res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package webhooks_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/webhooks"
+)
+
+// fakeAPI serves the webhook and run endpoints of account 1, completing runs
+// of job 10 with an error as soon as they're triggered and posting their
+// events to the webhook created through it.
+func fakeAPI(t *testing.T) *httptest.Server {
+	const secret = "fake-secret"
+	var webhook *dbt_cloud.Webhook
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v3/accounts/1/webhooks/subscriptions":
+			webhook = &dbt_cloud.Webhook{}
+			if err := json.NewDecoder(r.Body).Decode(webhook); err != nil {
+				// t.Fatal must only be called from the goroutine of the test
+				t.Error(err)
+				webhook = nil
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			webhook.ID = "wsu_fake"
+			webhook.HMACSecret = secret
+			json.NewEncoder(w).Encode(dbt_cloud.WebhookResponse{Data: *webhook})
+		case "POST /v2/accounts/1/jobs/10/run/":
+			id := 100
+			message := "Database Error"
+			started := "2021-11-05 12:00:00.000000+00:00"
+			finished := "2021-11-05 12:01:05.000000+00:00"
+			run := dbt_cloud.Run{
+				ID:              &id,
+				AccountID:       1,
+				ProjectID:       2,
+				EnvironmentID:   3,
+				JobDefinitionID: 10,
+				Status:          dbt_cloud.RUN_STATUS_ERROR,
+				StatusHumanized: "Error",
+				StatusMessage:   &message,
+				StartedAt:       &started,
+				FinishedAt:      &finished,
+				Trigger:         &dbt_cloud.RunTrigger{Cause: "Triggered by a test"},
+			}
+			if webhook != nil {
+				for _, eventType := range webhooks.RunEventTypes(run.Status) {
+					if err := webhooks.Send(http.DefaultClient, webhook.ClientURL, webhook.HMACSecret, webhooks.NewRunEvent(eventType, *webhook, run)); err != nil {
+						t.Error(err)
+					}
+				}
+			}
+			w.Write([]byte(`{"data": {"id": 100, "job_definition_id": 10, "status": 20}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestReceiverGetsRunEvents(t *testing.T) {
+	api := fakeAPI(t)
+	defer api.Close()
+
+	c := dbt_cloud.Client{HostURL: api.URL, HTTPClient: api.Client(), AccountID: 1}
+
+	// The secret is only known once the webhook is created, as with dbt Cloud
+	var receiver *webhooks.Receiver
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receiver.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	webhook, err := c.CreateWebhook("fake", "", server.URL, dbt_cloud.WebhookEventTypes, []int{10}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiver = webhooks.NewReceiver(webhook.HMACSecret, 10)
+
+	if _, err := c.TriggerJobRun(10, dbt_cloud.JobRunOptions{Cause: "Triggered by a test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{dbt_cloud.WEBHOOK_EVENT_JOB_RUN_COMPLETED, dbt_cloud.WEBHOOK_EVENT_JOB_RUN_ERRORED} {
+		select {
+		case event := <-receiver.Events:
+			if event.EventType != expected || event.WebhookID != "wsu_fake" || event.RunID != 100 || event.JobID != 10 {
+				t.Errorf("expected a %s event of run 100, got %+v", expected, event)
+			}
+			if event.RunStatusMessage != "Database Error" || event.RunFinishedAt.Sub(event.RunStartedAt) != 65*time.Second {
+				t.Errorf("unexpected run details %+v", event)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no %s event received", expected)
+		}
+	}
+}
+
+func TestReceiverRejectsInvalidSignatures(t *testing.T) {
+	receiver := webhooks.NewReceiver("secret", 1)
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	event := webhooks.RunEvent{EventType: dbt_cloud.WEBHOOK_EVENT_JOB_RUN_STARTED, RunID: 1}
+	if err := webhooks.Send(server.Client(), server.URL, "other", event); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected the event to be rejected, got %v", err)
+	}
+	if len(receiver.Events) != 0 {
+		t.Error("expected the rejected event not to be received")
+	}
+
+	if err := webhooks.Send(server.Client(), server.URL, "secret", event); err != nil {
+		t.Fatal(err)
+	}
+	if received := <-receiver.Events; received.RunID != 1 {
+		t.Errorf("unexpected event %+v", received)
+	}
+}
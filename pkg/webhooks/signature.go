@@ -0,0 +1,39 @@
+// Package webhooks verifies and decodes the job run events dbt Cloud posts
+// to the URLs of dbt_cloud_webhook resources.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+// SIGNATURE_HEADER is the header dbt Cloud signs the payloads in
+const SIGNATURE_HEADER = "Authorization"
+
+// ErrInvalidSignature is returned for payloads not signed with the secret
+// of the webhook
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload with the secret of
+// the webhook, as dbt Cloud sends it
+func Sign(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks that the raw payload was signed with the secret of
+// the webhook, comparing in constant time
+func VerifySignature(payload []byte, signature string, secret string) error {
+	expected, err := hex.DecodeString(Sign(payload, secret))
+	if err != nil {
+		return err
+	}
+	actual, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, actual) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package webhooks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/dbt_cloud"
+	"github.com/gthesheep/terraform-provider-dbt-cloud/pkg/webhooks"
+)
+
+// Example job.run.completed payload from the dbt Cloud documentation
+const documentedPayload = `{"accountId":1,"webhooksID":"wsu_12345abcde","eventId":"wev_2L6Z3l8uPedXKPq9D2nWbPIip7Z","timestamp":"2023-01-31T19:28:15.742843678Z","eventType":"job.run.completed","webhookName":"test","data":{"jobId":"123","jobName":"Daily Job (dbt build)","runId":"12345","environmentId":"1234","environmentName":"Production","dbtVersion":"1.0.0","projectName":"Snowflake Github Demo","projectId":"167194","runStatus":"Success","runStatusCode":10,"runStatusMessage":"None","runReason":"Kicked off from UI by test@test.com","runStartedAt":"2023-01-31T19:28:07Z","runFinishedAt":"2023-01-31T19:29:32Z"}}`
+
+func TestSign(t *testing.T) {
+	// Well known HMAC-SHA256 test vector
+	signature := webhooks.Sign([]byte("The quick brown fox jumps over the lazy dog"), "key")
+	if signature != "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8" {
+		t.Errorf("unexpected signature %s", signature)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	secret := "613e9312de1e2e5dd4f9a5cf0c6a7ad6b1e5c6bd"
+	payload := []byte(documentedPayload)
+	signature := webhooks.Sign(payload, secret)
+
+	if err := webhooks.VerifySignature(payload, signature, secret); err != nil {
+		t.Errorf("expected the signature to be valid, got %s", err)
+	}
+
+	for name, check := range map[string]func() error{
+		"other secret":    func() error { return webhooks.VerifySignature(payload, signature, "other") },
+		"altered payload": func() error { return webhooks.VerifySignature(append(payload, ' '), signature, secret) },
+		"not hex":         func() error { return webhooks.VerifySignature(payload, "not hex", secret) },
+		"missing":         func() error { return webhooks.VerifySignature(payload, "", secret) },
+	} {
+		if err := check(); err != webhooks.ErrInvalidSignature {
+			t.Errorf("%s: expected an invalid signature, got %v", name, err)
+		}
+	}
+}
+
+func TestParseRunEvent(t *testing.T) {
+	event, err := webhooks.ParseRunEvent([]byte(documentedPayload))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.EventType != dbt_cloud.WEBHOOK_EVENT_JOB_RUN_COMPLETED || event.JobID != 123 || event.RunID != 12345 || event.ProjectID != 167194 || event.EnvironmentID != 1234 {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if event.RunStatusCode != dbt_cloud.RUN_STATUS_SUCCESS || !event.RunFinishedAt.Equal(time.Date(2023, 1, 31, 19, 29, 32, 0, time.UTC)) {
+		t.Errorf("unexpected run status %d finished at %s", event.RunStatusCode, event.RunFinishedAt)
+	}
+
+	if _, err := webhooks.ParseRunEvent([]byte(`{"eventType": "job.run.paused", "data": {}}`)); err == nil {
+		t.Error("expected an error for an unknown event type")
+	}
+	if _, err := webhooks.ParseRunEvent([]byte(`{"eventType": "job.run.started", "data": {"runId": "abc"}}`)); err == nil {
+		t.Error("expected an error for an invalid run ID")
+	}
+}